@@ -0,0 +1,297 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+)
+
+// CAM16 color appearance model, the successor of CIECAM02, which predicts how
+// colors look under given viewing conditions. Implemented as in Material's
+// color utilities, with J, C, M, s and Q in [0..100] and more, as usual.
+// C. Li et al., "Comprehensive color solutions: CAM16, CAT16, and CAM16-UCS",
+// Color Research & Application 42, 2017.
+
+// The white point of the sRGB colors, with Y in [0..100].
+var cam16White = [3]float64{95.047, 100.0, 108.883}
+
+// The sRGB to XYZ matrix used by CAM16, with Y exactly the one of sRGB.
+var (
+  cam16XyzFromLinRgb = [3][3]float64{
+    {0.41233895, 0.35762064, 0.18051042},
+    {0.2126, 0.7152, 0.0722},
+    {0.01932141, 0.11916382, 0.95034478},
+  }
+  cam16LinRgbFromXyz = [3][3]float64{
+    {3.2413774792388685, -1.5376652402851851, -0.49885366846268053},
+    {-0.9691452513005321, 1.8758853451067872, 0.04156585616912061},
+    {0.05562093689691305, -0.20395524564742123, 1.0571799111220335},
+  }
+)
+
+// CAT16, from XYZ to the cone responses and back.
+var (
+  cam16FromXyz = [3][3]float64{
+    {0.401288, 0.650173, -0.051461},
+    {-0.250268, 1.204414, 0.045854},
+    {-0.002079, 0.048952, 0.953127},
+  }
+  cam16ToXyz = [3][3]float64{
+    {1.86206786, -1.01125463, 0.14918677},
+    {0.38752654, 0.62144744, -0.00897398},
+    {-0.0158415, -0.03412294, 1.04996444},
+  }
+)
+
+// ViewingConditions are the parts of CAM16 which only depend on how colors
+// are viewed, so they are computed once.
+type ViewingConditions struct {
+  n, aw, nbb, ncb, c, nc, fl, flRoot, z float64
+  rgbD                                  [3]float64
+}
+
+// The surround, i.e. the luminance around the viewed colors relative to their
+// white: dark for a projector in a dark room, dim for a TV in a living room and
+// average for paper or a screen in an office.
+const (
+  SurroundDark    = 0.0
+  SurroundDim     = 1.0
+  SurroundAverage = 2.0
+)
+
+// NewViewingConditions computes the conditions for the white point, such as D65
+// or D50, the luminance of the adapting field in cd/m² (typically a fifth of the
+// one of the white), the luminance of the background relative to the white in
+// [0..1] (0.2 for a gray world), the surround in [0..2] and whether the
+// illuminant is discounted, i.e. the colors are seen as lit objects.
+func NewViewingConditions(white [3]float64, adaptingLuminance, backgroundY, surround float64, discounting bool) *ViewingConditions {
+  return newViewingConditions([3]float64{white[0] * 100.0, white[1] * 100.0, white[2] * 100.0},
+    adaptingLuminance, backgroundY*100.0, surround, discounting)
+}
+
+// newViewingConditions is NewViewingConditions with Y in [0..100].
+func newViewingConditions(white [3]float64, adaptingLuminance, backgroundY, surround float64, discounting bool) *ViewingConditions {
+  rW, gW, bW := mulMat3(cam16FromXyz, white[0], white[1], white[2])
+  f := 0.8 + surround/10.0
+  var c float64
+  if f >= 0.9 {
+    c = 0.59 + (0.69-0.59)*((f-0.9)*10.0)
+  } else {
+    c = 0.525 + (0.59-0.525)*((f-0.8)*10.0)
+  }
+  d := 1.0
+  if !discounting {
+    d = f * (1.0 - (1.0/3.6)*math.Exp((-adaptingLuminance-42.0)/92.0))
+  }
+  d = math.Max(0.0, math.Min(1.0, d))
+  rgbD := [3]float64{d*(100.0/rW) + 1.0 - d, d*(100.0/gW) + 1.0 - d, d*(100.0/bW) + 1.0 - d}
+  k := 1.0 / (5.0*adaptingLuminance + 1.0)
+  k4 := k * k * k * k
+  k4F := 1.0 - k4
+  fl := k4*adaptingLuminance + 0.1*k4F*k4F*math.Cbrt(5.0*adaptingLuminance)
+  n := backgroundY / white[1]
+  z := 1.48 + math.Sqrt(n)
+  nbb := 0.725 / math.Pow(n, 0.2)
+
+  var rgbA [3]float64
+  for i, w := range [3]float64{rW, gW, bW} {
+    af := math.Pow(fl*rgbD[i]*w/100.0, 0.42)
+    rgbA[i] = 400.0 * af / (af + 27.13)
+  }
+  aw := (2.0*rgbA[0] + rgbA[1] + 0.05*rgbA[2]) * nbb
+  return &ViewingConditions{
+    n: n, aw: aw, nbb: nbb, ncb: nbb, c: c, nc: f, fl: fl, flRoot: math.Pow(fl, 0.25), z: z, rgbD: rgbD,
+  }
+}
+
+// The conditions of Material: sRGB white, a background of L* 50 in an average
+// surround at 200 lux. Also the ones of HCT.
+var DefaultViewingConditions = newViewingConditions(cam16White, 200.0/math.Pi*yFromLstar(50.0)/100.0, yFromLstar(50.0), SurroundAverage, false)
+
+// ColorCam16 are the CAM16 correlates: lightness J, chroma C, hue h in degrees,
+// colorfulness M, saturation s and brightness Q.
+type ColorCam16 struct {
+  J, C, H, M, S, Q float64
+}
+
+// Cam16 gives the appearance of the color under the viewing conditions.
+func (c Color) Cam16(vc *ViewingConditions) ColorCam16 {
+  lin := c.LinearRgb()
+  return vc.cam16(mulMat3(cam16XyzFromLinRgb, lin.R*100.0, lin.G*100.0, lin.B*100.0))
+}
+
+// Cam16 gives the appearance of the XYZ color under the viewing conditions.
+func (xyz ColorXyz) Cam16(vc *ViewingConditions) ColorCam16 {
+  return vc.cam16(xyz.X*100.0, xyz.Y*100.0, xyz.Z*100.0)
+}
+
+// Color gives the color which appears as cam under the viewing conditions. Only
+// J, C and h are used. It may be outside of sRGB.
+func (cam ColorCam16) Color(vc *ViewingConditions) Color {
+  x, y, z := vc.xyz(cam)
+  r, g, b := mulMat3(cam16LinRgbFromXyz, x, y, z)
+  return LinearRgb(r/100.0, g/100.0, b/100.0)
+}
+
+// Xyz gives the XYZ color which appears as cam under the viewing conditions.
+// Only J, C and h are used.
+func (cam ColorCam16) Xyz(vc *ViewingConditions) ColorXyz {
+  x, y, z := vc.xyz(cam)
+  return ColorXyz{x / 100.0, y / 100.0, z / 100.0}
+}
+
+// cam16 computes the correlates of XYZ with Y in [0..100].
+func (vc *ViewingConditions) cam16(x, y, z float64) ColorCam16 {
+  rC, gC, bC := mulMat3(cam16FromXyz, x, y, z)
+
+  var rgbA [3]float64
+  for i, v := range [3]float64{rC, gC, bC} {
+    d := vc.rgbD[i] * v
+    af := math.Pow(vc.fl*math.Abs(d)/100.0, 0.42)
+    rgbA[i] = sign(d) * 400.0 * af / (af + 27.13)
+  }
+  rA, gA, bA := rgbA[0], rgbA[1], rgbA[2]
+
+  a := (11.0*rA + -12.0*gA + bA) / 11.0
+  b := (rA + gA - 2.0*bA) / 9.0
+  u := (20.0*rA + 20.0*gA + 21.0*bA) / 20.0
+  p2 := (40.0*rA + 20.0*gA + bA) / 20.0
+
+  hue := math.Atan2(b, a) * 180.0 / math.Pi
+  if hue < 0.0 {
+    hue += 360.0
+  } else if hue >= 360.0 {
+    hue -= 360.0
+  }
+
+  ac := p2 * vc.nbb
+  j := 100.0 * math.Pow(ac/vc.aw, vc.c*vc.z)
+  q := (4.0 / vc.c) * math.Sqrt(j/100.0) * (vc.aw + 4.0) * vc.flRoot
+  huePrime := hue
+  if hue < 20.14 {
+    huePrime += 360.0
+  }
+  eHue := 0.25 * (math.Cos(huePrime*math.Pi/180.0+2.0) + 3.8)
+  p1 := (50000.0 / 13.0) * eHue * vc.nc * vc.ncb
+  t := p1 * math.Sqrt(a*a+b*b) / (u + 0.305)
+  alpha := math.Pow(t, 0.9) * math.Pow(1.64-math.Pow(0.29, vc.n), 0.73)
+  c := alpha * math.Sqrt(j/100.0)
+  m := c * vc.flRoot
+  s := 50.0 * math.Sqrt(alpha*vc.c/(vc.aw+4.0))
+  return ColorCam16{J: j, C: c, H: hue, M: m, S: s, Q: q}
+}
+
+// xyz is the inverse of cam16, from lightness J, chroma C and hue h only.
+func (vc *ViewingConditions) xyz(cam ColorCam16) (x, y, z float64) {
+  alpha := 0.0
+  if cam.C != 0.0 && cam.J != 0.0 {
+    alpha = cam.C / math.Sqrt(cam.J/100.0)
+  }
+  t := math.Pow(alpha/math.Pow(1.64-math.Pow(0.29, vc.n), 0.73), 1.0/0.9)
+  hRad := cam.H * math.Pi / 180.0
+  eHue := 0.25 * (math.Cos(hRad+2.0) + 3.8)
+  ac := vc.aw * math.Pow(cam.J/100.0, 1.0/vc.c/vc.z)
+  p1 := eHue * (50000.0 / 13.0) * vc.nc * vc.ncb
+  p2 := ac / vc.nbb
+  hSin, hCos := math.Sin(hRad), math.Cos(hRad)
+  gamma := 23.0 * (p2 + 0.305) * t / (23.0*p1 + 11.0*t*hCos + 108.0*t*hSin)
+  a, b := gamma*hCos, gamma*hSin
+  rA := (460.0*p2 + 451.0*a + 288.0*b) / 1403.0
+  gA := (460.0*p2 - 891.0*a - 261.0*b) / 1403.0
+  bA := (460.0*p2 - 220.0*a - 6300.0*b) / 1403.0
+
+  var rgbF [3]float64
+  for i, v := range [3]float64{rA, gA, bA} {
+    base := math.Max(0.0, 27.13*math.Abs(v)/(400.0-math.Abs(v)))
+    rgbF[i] = sign(v) * (100.0 / vc.fl) * math.Pow(base, 1.0/0.42) / vc.rgbD[i]
+  }
+  return mulMat3(cam16ToXyz, rgbF[0], rgbF[1], rgbF[2])
+}
+
+///////////////////////////////////////////////////////////////////////////////
+/// CAM16-UCS
+///////////////////////////////////////////////////////////////////////////////
+
+// ColorCam16Ucs is the uniform color space of CAM16, in which Euclidean
+// distances are color differences. J in [0..100], A and B about [-50..50].
+type ColorCam16Ucs struct {
+  J, A, B float64
+}
+
+func (cam ColorCam16) Ucs() ColorCam16Ucs {
+  j := 1.7 * cam.J / (1.0 + 0.007*cam.J)
+  m := math.Log(1.0+0.0228*cam.M) / 0.0228
+  h := cam.H * math.Pi / 180.0
+  return ColorCam16Ucs{j, m * math.Cos(h), m * math.Sin(h)}
+}
+
+// Cam16 gives all the correlates under the viewing conditions.
+func (ucs ColorCam16Ucs) Cam16(vc *ViewingConditions) ColorCam16 {
+  m := (math.Exp(math.Hypot(ucs.A, ucs.B)*0.0228) - 1.0) / 0.0228
+  h := math.Mod(math.Atan2(ucs.B, ucs.A)*180.0/math.Pi+360.0, 360.0)
+  j := ucs.J / (1.7 - 0.007*ucs.J)
+  return vc.cam16(vc.xyz(ColorCam16{J: j, C: m / vc.flRoot, H: h}))
+}
+
+// Dist is the Euclidean distance in CAM16-UCS, about as large as CIELAB ones.
+func (ucs ColorCam16Ucs) Dist(ucs2 ColorCam16Ucs) float64 {
+  return math.Sqrt(sq(ucs.J-ucs2.J) + sq(ucs.A-ucs2.A) + sq(ucs.B-ucs2.B))
+}
+
+// DistanceCAM16UCS is the color difference in CAM16-UCS under the default
+// viewing conditions, divided by 100 like the other distances.
+func (c1 Color) DistanceCAM16UCS(c2 Color) float64 {
+  return c1.DistanceCAM16UCSEx(c2, DefaultViewingConditions)
+}
+
+// DistanceCAM16UCSEx is DistanceCAM16UCS under the viewing conditions.
+func (c1 Color) DistanceCAM16UCSEx(c2 Color, vc *ViewingConditions) float64 {
+  return c1.Cam16(vc).Ucs().Dist(c2.Cam16(vc).Ucs()) / 100.0
+}
+
+func sign(v float64) float64 {
+  switch {
+  case v < 0.0:
+    return -1.0
+  case v > 0.0:
+    return 1.0
+  }
+  return 0.0
+}
+
+// yFromLstar is the luminance Y in [0..100] of the lightness L* in [0..100].
+func yFromLstar(lstar float64) float64 {
+  ft := (lstar + 16.0) / 116.0
+  if ft3 := ft * ft * ft; ft3 > 216.0/24389.0 {
+    return 100.0 * ft3
+  }
+  return 100.0 * (116.0*ft - 16.0) / (24389.0 / 27.0)
+}
+
+// lstarFromY is the lightness L* in [0..100] of the luminance Y in [0..100].
+func lstarFromY(y float64) float64 {
+  t := y / 100.0
+  if t > 216.0/24389.0 {
+    return 116.0*math.Cbrt(t) - 16.0
+  }
+  return 116.0*((24389.0/27.0*t+16.0)/116.0) - 16.0
+}
@@ -0,0 +1,99 @@
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+func TestCam16(t *testing.T) {
+  // Reference values of Material's color utilities.
+  tests := []struct {
+    c    Color
+    want ColorCam16
+  }{
+    {Color{1, 0, 0, 1}, ColorCam16{J: 46.445, C: 113.357, H: 27.408, M: 89.494, S: 91.889, Q: 105.988}},
+    {Color{0, 1, 0, 1}, ColorCam16{J: 79.331, C: 108.410, H: 142.139, M: 85.587, S: 78.604, Q: 138.520}},
+    {Color{0, 0, 1, 1}, ColorCam16{J: 25.465, C: 87.230, H: 282.788, M: 68.867, S: 93.675, Q: 78.481}},
+    {Color{1, 1, 1, 1}, ColorCam16{J: 100.0, C: 2.869, H: 209.492, M: 2.265, S: 12.068, Q: 155.521}},
+  }
+  for _, tt := range tests {
+    got := tt.c.Cam16(DefaultViewingConditions)
+    for _, v := range [][2]float64{{got.J, tt.want.J}, {got.C, tt.want.C}, {got.H, tt.want.H}, {got.M, tt.want.M}, {got.S, tt.want.S}, {got.Q, tt.want.Q}} {
+      if math.Abs(v[0]-v[1]) > 1e-3 {
+        t.Errorf("%v.Cam16() = %+v, want %+v", tt.c, got, tt.want)
+        break
+      }
+    }
+  }
+}
+
+func TestCam16RoundTrip(t *testing.T) {
+  conditions := []*ViewingConditions{
+    DefaultViewingConditions,
+    NewViewingConditions(D65, 64.0/math.Pi/5.0, 0.2, SurroundDim, false),
+    NewViewingConditions(D50, 500.0/math.Pi/5.0, 0.2, SurroundAverage, true),
+    NewViewingConditions(D65, 1.0, 0.05, SurroundDark, false),
+  }
+  // Up to the precision of the published inverse of CAT16.
+  colors := []Color{{0.2, 0.4, 0.6, 1}, {0.9, 0.1, 0.3, 1}, {0.5, 0.5, 0.5, 1}, {0.05, 0.95, 0.8, 1}}
+  for i, vc := range conditions {
+    for _, c := range colors {
+      cam := c.Cam16(vc)
+      if got := cam.Color(vc); !almostEqualColor(got, c, 1e-6) {
+        t.Errorf("conditions %v: %v round trips to %v", i, c, got)
+      }
+      if got := cam.Ucs().Cam16(vc); math.Abs(got.J-cam.J) > 1e-5 || math.Abs(got.C-cam.C) > 1e-5 || math.Abs(got.S-cam.S) > 1e-5 {
+        t.Errorf("conditions %v: %+v round trips through UCS to %+v", i, cam, got)
+      }
+      xyz := c.Xyz()
+      if got := xyz.Cam16(vc).Xyz(vc); math.Abs(got.X-xyz.X) > 1e-7 || math.Abs(got.Y-xyz.Y) > 1e-7 || math.Abs(got.Z-xyz.Z) > 1e-7 {
+        t.Errorf("conditions %v: %v round trips to %v", i, xyz, got)
+      }
+    }
+  }
+}
+
+func TestViewingConditions(t *testing.T) {
+  // The same as the default ones, up to rounding.
+  vc := NewViewingConditions(D65, 200.0/math.Pi*yFromLstar(50.0)/100.0, yFromLstar(50.0)/100.0, SurroundAverage, false)
+  c := Color{0.3, 0.6, 0.2, 1}
+  if got, want := c.Cam16(vc), c.Cam16(DefaultViewingConditions); math.Abs(got.J-want.J) > 1e-3 || math.Abs(got.C-want.C) > 1e-3 {
+    t.Errorf("D65 conditions give %+v, want %+v", got, want)
+  }
+
+  // A dim surround makes colors look lighter and more colorful, a bright
+  // adapting field makes them look brighter.
+  dim := NewViewingConditions(D65, 20.0, 0.2, SurroundDim, false)
+  average := NewViewingConditions(D65, 20.0, 0.2, SurroundAverage, false)
+  bright := NewViewingConditions(D65, 200.0, 0.2, SurroundAverage, false)
+  camDim, camAverage, camBright := c.Cam16(dim), c.Cam16(average), c.Cam16(bright)
+  if camDim.J <= camAverage.J {
+    t.Errorf("lightness %v in a dim surround isn't higher than %v in an average one", camDim.J, camAverage.J)
+  }
+  if camBright.Q <= camAverage.Q || camBright.M <= camAverage.M {
+    t.Errorf("brightness %v and colorfulness %v in bright light aren't higher than %v and %v", camBright.Q, camBright.M, camAverage.Q, camAverage.M)
+  }
+}
+
+func TestDistanceCAM16UCS(t *testing.T) {
+  black, white := Color{0, 0, 0, 1}, Color{1, 1, 1, 1}
+  if d := black.DistanceCAM16UCS(white); math.Abs(d-1.0) > 0.05 {
+    t.Errorf("black to white is %v, want about 1", d)
+  }
+
+  c1, c2, c3 := Color{0.2, 0.4, 0.6, 1}, Color{0.21, 0.4, 0.6, 1}, Color{0.6, 0.4, 0.2, 1}
+  if d := c1.DistanceCAM16UCS(c1); d != 0.0 {
+    t.Errorf("distance to itself is %v", d)
+  }
+  if d1, d2 := c1.DistanceCAM16UCS(c2), c2.DistanceCAM16UCS(c1); math.Abs(d1-d2) > 1e-12 {
+    t.Errorf("distance isn't symmetric: %v and %v", d1, d2)
+  }
+  if d1, d2 := c1.DistanceCAM16UCS(c2), c1.DistanceCAM16UCS(c3); d1 >= d2 {
+    t.Errorf("close colors are %v apart, far ones %v", d1, d2)
+  }
+
+  // Same scale as the other distances.
+  if d, lab := c1.DistanceCAM16UCS(c3), c1.DistanceCIEDE2000(c3); d < lab/2.0 || d > lab*2.0 {
+    t.Errorf("CAM16-UCS distance %v isn't about CIEDE2000 %v", d, lab)
+  }
+}
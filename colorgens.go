@@ -21,56 +21,72 @@
 
 package colorful
 
-import (
-  "math/rand"
-)
-
 // Creates a random dark, "warm" color through a restricted HSV space.
 func FastWarmColor() Color {
+  return defaultGenerator.FastWarmColor()
+}
+
+// FastWarmColor with the Generator as source of randomness.
+func (g *Generator) FastWarmColor() Color {
   return ColorHsv{
-    rand.Float64() * 360.0,
-    rand.Float64()*0.3 + 0.5,
-    rand.Float64()*0.3 + 0.3}.Color()
+    g.float64() * 360.0,
+    g.float64()*0.3 + 0.5,
+    g.float64()*0.3 + 0.3}.Color()
 }
 
 // Creates a random dark, "warm" color through restricted HCL space.
 // This is slower than FastWarmColor but will likely give you colors which have
 // the same "warmness" if you run it many times.
-func WarmColor() (c Color) {
-  for c = randomWarm(); !c.IsValid(); c = randomWarm() {
+func WarmColor() Color {
+  return defaultGenerator.WarmColor()
+}
+
+// WarmColor with the Generator as source of randomness.
+func (g *Generator) WarmColor() (c Color) {
+  for c = g.randomWarm(); !c.IsValid(); c = g.randomWarm() {
     // DUMMY LOOP BODY ...
   }
   return
 }
 
-func randomWarm() Color {
+func (g *Generator) randomWarm() Color {
   return ColorHcl{
-    rand.Float64() * 360.0,
-    rand.Float64()*0.3 + 0.1,
-    rand.Float64()*0.3 + 0.2}.Color()
+    g.float64() * 360.0,
+    g.float64()*0.3 + 0.1,
+    g.float64()*0.3 + 0.2}.Color()
 }
 
 // Creates a random bright, "pimpy" color through a restricted HSV space.
 func FastHappyColor() Color {
+  return defaultGenerator.FastHappyColor()
+}
+
+// FastHappyColor with the Generator as source of randomness.
+func (g *Generator) FastHappyColor() Color {
   return ColorHsv{
-    rand.Float64() * 360.0,
-    rand.Float64()*0.3 + 0.7,
-    rand.Float64()*0.3 + 0.6}.Color()
+    g.float64() * 360.0,
+    g.float64()*0.3 + 0.7,
+    g.float64()*0.3 + 0.6}.Color()
 }
 
 // Creates a random bright, "pimpy" color through restricted HCL space.
 // This is slower than FastHappyColor but will likely give you colors which
 // have the same "brightness" if you run it many times.
-func HappyColor() (c Color) {
-  for c = randomPimp(); !c.IsValid(); c = randomPimp() {
+func HappyColor() Color {
+  return defaultGenerator.HappyColor()
+}
+
+// HappyColor with the Generator as source of randomness.
+func (g *Generator) HappyColor() (c Color) {
+  for c = g.randomPimp(); !c.IsValid(); c = g.randomPimp() {
     // DUMMY LOOP BODY ...
   }
   return
 }
 
-func randomPimp() Color {
+func (g *Generator) randomPimp() Color {
   return ColorHcl{
-    rand.Float64() * 360.0,
-    rand.Float64()*0.3 + 0.5,
-    rand.Float64()*0.3 + 0.5}.Color()
+    g.float64() * 360.0,
+    g.float64()*0.3 + 0.5,
+    g.float64()*0.3 + 0.5}.Color()
 }
@@ -0,0 +1,133 @@
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+func TestColormap(t *testing.T) {
+  tests := []struct {
+    name string
+    t    float64
+    want string
+  }{
+    {"viridis", 0.0, "#440154"},
+    {"viridis", 0.5, "#21918d"},
+    {"Viridis", 1.0, "#fde725"},
+    {"magma", 0.1, "#150e37"},
+    {"cividis", 1.0, "#ffea46"},
+    {"RdBu", 0.5, "#f7f7f7"},
+    {"blues", 1.0, "#08306b"},
+    {"Set1", 0.0, "#e41a1c"},
+    {"Set1", 0.2, "#377eb8"},
+    {"Set1", 1.0, "#999999"},
+  }
+  for _, tt := range tests {
+    if got := Colormap(tt.name).At(tt.t).HexString(); got != tt.want {
+      t.Errorf("Colormap(%q).At(%v) = %v, want %v", tt.name, tt.t, got, tt.want)
+    }
+  }
+
+  if got := Colormap("no such map").Colors(3); len(got) != 0 {
+    t.Errorf("unknown colormap has colors %v", got)
+  }
+  if _, ok := LookupColormap("no such map"); ok {
+    t.Errorf("found an unknown colormap")
+  }
+}
+
+func TestColormapLUT(t *testing.T) {
+  // Entries of the 256 color tables of matplotlib and of Turbo, which the
+  // approximations stay close to.
+  tests := []struct {
+    name string
+    t    float64
+    want Color
+    eps  float64
+  }{
+    {"viridis", 0.0, Color{0.267004, 0.004874, 0.329415, 1}, 1.0 / 255.0},
+    {"viridis", 128.0 / 255.0, Color{0.127568, 0.566949, 0.550556, 1}, 1.0 / 255.0},
+    {"viridis", 1.0, Color{0.993248, 0.906157, 0.143936, 1}, 1.0 / 255.0},
+    {"plasma", 0.0, Color{0.050383, 0.029803, 0.527975, 1}, 1.0 / 255.0},
+    {"plasma", 128.0 / 255.0, Color{0.798216, 0.280197, 0.469538, 1}, 1.0 / 255.0},
+    {"plasma", 1.0, Color{0.940015, 0.975158, 0.131326, 1}, 1.0 / 255.0},
+    {"inferno", 0.0, Color{0.001462, 0.000466, 0.013866, 1}, 1.0 / 255.0},
+    {"inferno", 128.0 / 255.0, Color{0.735683, 0.215906, 0.330245, 1}, 1.0 / 255.0},
+    {"inferno", 1.0, Color{0.988362, 0.998364, 0.644924, 1}, 1.0 / 255.0},
+    {"magma", 0.0, Color{0.001462, 0.000466, 0.013866, 1}, 1.0 / 255.0},
+    {"magma", 128.0 / 255.0, Color{0.716387, 0.214982, 0.475290, 1}, 1.0 / 255.0},
+    {"magma", 1.0, Color{0.987053, 0.991438, 0.749504, 1}, 1.0 / 255.0},
+    // cividis and turbo are only approximations.
+    {"cividis", 0.0, Color{0.0, 0.135112, 0.304751, 1}, 3.0 / 255.0},
+    // The cividis of viridisLite has a less saturated yellow end.
+    {"cividis", 1.0, Color{0.995737, 0.909344, 0.217772, 1}, 15.0 / 255.0},
+    // The polynomial of Turbo is worst at its ends.
+    {"turbo", 0.0, Color{0.18995, 0.07176, 0.23217, 1}, 0.13},
+    {"turbo", 1.0, Color{0.47960, 0.01583, 0.01055, 1}, 0.13},
+  }
+  for _, tt := range tests {
+    if got := Colormap(tt.name).At(tt.t); !almostEqualColor(got, tt.want, tt.eps) {
+      t.Errorf("Colormap(%q).At(%v) = %v, want %v", tt.name, tt.t, got, tt.want)
+    }
+  }
+
+  for _, name := range ColormapNames() {
+    d, _ := LookupColormap(name)
+    if want := name == "cividis" || name == "turbo"; d.Approximate != want {
+      t.Errorf("colormap %q has Approximate %v", name, d.Approximate)
+    }
+    if d.Source == sourceMatplotlib && len(d.Colors) != 256 {
+      t.Errorf("colormap %q has %v colors, want 256", name, len(d.Colors))
+    }
+  }
+}
+
+func TestColormapReversed(t *testing.T) {
+  g := Colormap("plasma")
+  r := g.Reversed()
+  for _, x := range []float64{0.0, 0.13, 0.5, 0.77, 1.0} {
+    if got, want := r.At(x), g.At(1.0-x); !almostEqualColor(got, want, 1e-9) {
+      t.Errorf("Reversed().At(%v) = %v, want %v", x, got, want)
+    }
+  }
+
+  // Bands of qualitative maps stay bands.
+  q := Colormap("Dark2").Reversed()
+  if got := q.At(0.01).HexString(); got != "#666666" {
+    t.Errorf("reversed Dark2 starts with %v", got)
+  }
+}
+
+func TestColormapData(t *testing.T) {
+  names := ColormapNames()
+  if len(names) != 41 {
+    t.Errorf("got %v colormaps, want 41", len(names))
+  }
+  for _, name := range names {
+    d, ok := LookupColormap(name)
+    if !ok || d.Name != name || d.Source == "" || len(d.Colors) < 8 {
+      t.Errorf("colormap %q: %v, %v", name, d, ok)
+      continue
+    }
+
+    // Sequential maps get monotonically lighter or darker, which catches typos.
+    // Neighbors in the 256 color tables may dip a little through their 8 bits.
+    if d.Kind != Sequential || name == "turbo" {
+      continue
+    }
+    eps := 0.0
+    if len(d.Colors) == 256 {
+      eps = 0.001
+    }
+    sign := d.Colors[len(d.Colors)-1].Lab().L - d.Colors[0].Lab().L
+    for i := 1; i < len(d.Colors); i++ {
+      if (d.Colors[i].Lab().L-d.Colors[i-1].Lab().L)*math.Copysign(1.0, sign) <= -eps {
+        t.Errorf("colormap %q isn't monotonic in lightness at %v", name, i)
+      }
+    }
+  }
+
+  if k := Diverging.String(); k != "diverging" {
+    t.Errorf("Diverging.String() = %v", k)
+  }
+}
@@ -22,6 +22,7 @@ package colorful
 
 import (
   "fmt"
+  "image/color"
   "math"
 )
 
@@ -29,6 +30,57 @@ type Color struct {
   R, G, B, A float64
 }
 
+///////////////////////////////////////////////////////////////////////////////
+/// image/color
+///////////////////////////////////////////////////////////////////////////////
+
+// Model converts any color.Color into a colorful Color.
+var Model = color.ModelFunc(colorfulModel)
+
+func colorfulModel(c color.Color) color.Color {
+  col, _ := MakeColor(c)
+  return col
+}
+
+// RGBA implements the color.Color interface. The returned values are
+// alpha-premultiplied and in [0..0xffff], out of range channels are clamped.
+func (c Color) RGBA() (r, g, b, a uint32) {
+  alpha := clamp01(c.A)
+  a = uint32(alpha*65535.0 + 0.5)
+  r = uint32(clamp01(c.R)*alpha*65535.0 + 0.5)
+  g = uint32(clamp01(c.G)*alpha*65535.0 + 0.5)
+  b = uint32(clamp01(c.B)*alpha*65535.0 + 0.5)
+  return
+}
+
+// MakeColor constructs a colorful Color from anything implementing color.Color.
+// The channels are un-premultiplied, the second value tells whether the source
+// color was fully opaque. A fully transparent source gives a transparent black.
+func MakeColor(col color.Color) (Color, bool) {
+  if c, ok := col.(Color); ok {
+    return c, c.A >= 1.0
+  }
+
+  r, g, b, a := col.RGBA()
+  if a == 0 {
+    return Color{0.0, 0.0, 0.0, 0.0}, false
+  }
+
+  // Since the stdlib colors are premultiplied, we need to divide by alpha.
+  if a != 0xffff {
+    r = r * 0xffff / a
+    g = g * 0xffff / a
+    b = b * 0xffff / a
+  }
+
+  return Color{
+    float64(r) / 65535.0,
+    float64(g) / 65535.0,
+    float64(b) / 65535.0,
+    float64(a) / 65535.0,
+  }, a == 0xffff
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 /// Hex
 ///////////////////////////////////////////////////////////////////////////////
@@ -115,9 +167,11 @@ func (c Color) RGBString() string {
     uint8(c.R*255.0+0.5), uint8(c.G*255.0+0.5), uint8(c.B*255.0+0.5))
 }
 
+// RGBAString gives the 8-bit channels and the alpha, which like in the CSS
+// strings is the shortest value that reads back the same, e.g. "255, 0, 0, 0.5".
 func (c Color) RGBAString() string {
-  return fmt.Sprintf("%d, %d, %d, %f",
-    uint8(c.R*255.0+0.5), uint8(c.G*255.0+0.5), uint8(c.B*255.0+0.5), c.A)
+  return fmt.Sprintf("%d, %d, %d, %s",
+    uint8(c.R*255.0+0.5), uint8(c.G*255.0+0.5), uint8(c.B*255.0+0.5), cssNum(c.A, -1))
 }
 
 func (c Color) String() string {
@@ -418,6 +472,45 @@ func (c1 Color) BlendHsv(c2 Color, t float64) Color {
   return ColorHsv{H, h1.S + t*(h2.S-h1.S), h1.V + t*(h2.V-h1.V)}.Color()
 }
 
+///////////////////////////////////////////////////////////////////////////////
+/// HWB
+///////////////////////////////////////////////////////////////////////////////
+// https://www.w3.org/TR/css-color-4/#the-hwb-notation
+// HWB is HSV with the saturation and value replaced by whiteness and blackness.
+// Note that h is in [0..360] and w,b in [0..1]
+
+type ColorHwb struct {
+  H, W, B float64
+}
+
+// Hwb returns the Hue [0..360], Whiteness and Blackness [0..1] of the color.
+func (col Color) Hwb() ColorHwb {
+  return col.Hsv().Hwb()
+}
+
+func (c ColorHsv) Hwb() ColorHwb {
+  return ColorHwb{H: c.H, W: (1.0 - c.S) * c.V, B: 1.0 - c.V}
+}
+
+// Hsv converts the color to HSV, a whiteness and blackness adding up to more
+// than one are normalized and give a gray.
+func (c ColorHwb) Hsv() ColorHsv {
+  w, b := c.W, c.B
+  if w+b >= 1.0 {
+    return ColorHsv{H: c.H, S: 0.0, V: w / (w + b)}
+  }
+  v := 1.0 - b
+  s := 0.0
+  if v != 0.0 {
+    s = 1.0 - w/v
+  }
+  return ColorHsv{H: c.H, S: s, V: v}
+}
+
+func (c ColorHwb) Color() Color {
+  return c.Hsv().Color()
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 /// Hsl
 ///////////////////////////////////////////////////////////////////////////////
@@ -1027,6 +1120,147 @@ func (col1 Color) BlendHcl(col2 Color, t float64) Color {
   return ColorHcl{H, hcl1.C + t*(hcl2.C-hcl1.C), hcl1.L + t*(hcl2.L-hcl1.L)}.Color()
 }
 
+///////////////////////////////////////////////////////////////////////////////
+/// OKLab
+///////////////////////////////////////////////////////////////////////////////
+// https://bottosson.github.io/posts/oklab/
+// OKLab is a perceptual space like L*a*b*, but with much better hue linearity
+// (blues don't turn purple) and no dependency on a reference white.
+
+type ColorOkLab struct {
+  L, A, B float64
+}
+
+// Converts the given color to OKLab space.
+// L is in [0..1] and both a and b are in about [-0.4..0.4]
+func (c Color) OkLab() ColorOkLab {
+  lin := c.LinearRgb()
+  l := math.Cbrt(0.4122214708*lin.R + 0.5363325363*lin.G + 0.0514459929*lin.B)
+  m := math.Cbrt(0.2119034982*lin.R + 0.6806995451*lin.G + 0.1073969566*lin.B)
+  s := math.Cbrt(0.0883024619*lin.R + 0.2817188376*lin.G + 0.6299787005*lin.B)
+  return ColorOkLab{
+    L: 0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+    A: 1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+    B: 0.0259040371*l + 0.7827717662*m - 0.8086757660*s,
+  }
+}
+
+// Generates a color by using data given in OKLab space.
+func (c ColorOkLab) Color() Color {
+  l := cub(c.L + 0.3963377774*c.A + 0.2158037573*c.B)
+  m := cub(c.L - 0.1055613458*c.A - 0.0638541728*c.B)
+  s := cub(c.L - 0.0894841775*c.A - 1.2914855480*c.B)
+  return LinearRgb(
+    4.0767416621*l-3.3077115913*m+0.2309699292*s,
+    -1.2684380046*l+2.6097574011*m-0.3413193965*s,
+    -0.0041960863*l-0.7034186147*m+1.7076147010*s)
+}
+
+// OkLab converts a CIE L*a*b* color, handy for CheckColor functions of SoftPaletteSettings.
+func (c ColorLab) OkLab() ColorOkLab {
+  return c.Color().OkLab()
+}
+
+func (c ColorOkLab) Lab() ColorLab {
+  return c.Color().Lab()
+}
+
+// DistanceOkLab is the euclidean distance in OKLab space, a difference
+// of about 0.02 is just noticeable.
+func (c1 Color) DistanceOkLab(c2 Color) float64 {
+  return c1.OkLab().Dist(c2.OkLab())
+}
+
+// BlendOkLab blends two colors in the OKLab color-space.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendOkLab(c2 Color, t float64) Color {
+  l1 := c1.OkLab()
+  l2 := c2.OkLab()
+  return ColorOkLab{
+    l1.L + t*(l2.L-l1.L),
+    l1.A + t*(l2.A-l1.A),
+    l1.B + t*(l2.B-l1.B)}.Color()
+}
+
+func (lab1 ColorOkLab) Eq(lab2 ColorOkLab) bool {
+  return math.Abs(lab1.L-lab2.L) < LAB_DELTA &&
+    math.Abs(lab1.A-lab2.A) < LAB_DELTA &&
+    math.Abs(lab1.B-lab2.B) < LAB_DELTA
+}
+
+func (lab1 ColorOkLab) Dist(lab2 ColorOkLab) float64 {
+  return math.Sqrt(sq(lab1.L-lab2.L) + sq(lab1.A-lab2.A) + sq(lab1.B-lab2.B))
+}
+
+// DistOkLab measures the distance of two L*a*b* colors in OKLab space.
+// It has the signature of SoftPaletteSettings.Distance.
+func (lab1 ColorLab) DistOkLab(lab2 ColorLab) float64 {
+  return lab1.OkLab().Dist(lab2.OkLab())
+}
+
+///////////////////////////////////////////////////////////////////////////////
+/// OKLCH
+///////////////////////////////////////////////////////////////////////////////
+// OKLCH is OKLab in cylindrical coordinates, like HCL is for L*a*b*.
+
+type ColorOkLch struct {
+  L, C, H float64
+}
+
+// Converts the given color to OKLCH space.
+// L is in [0..1], C in about [0..0.4] and H in [0..360]
+func (c Color) OkLch() ColorOkLch {
+  return c.OkLab().OkLch()
+}
+
+func (c ColorOkLab) OkLch() ColorOkLch {
+  h := math.Mod(math.Atan2(c.B, c.A)*180.0/math.Pi+360.0, 360.0)
+  return ColorOkLch{L: c.L, C: math.Sqrt(sq(c.A) + sq(c.B)), H: h}
+}
+
+func (c ColorOkLch) OkLab() ColorOkLab {
+  h := c.H * math.Pi / 180.0
+  return ColorOkLab{L: c.L, A: c.C * math.Cos(h), B: c.C * math.Sin(h)}
+}
+
+// Generates a color by using data given in OKLCH space.
+func (c ColorOkLch) Color() Color {
+  return c.OkLab().Color()
+}
+
+// BlendOkLch blends two colors in the OKLCH color-space along the shorter hue arc.
+// The hue of a gray is meaningless, so blending with one keeps the other hue.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendOkLch(c2 Color, t float64) Color {
+  lch1 := c1.OkLch()
+  lch2 := c2.OkLch()
+
+  if lch1.C < okLchAchromatic {
+    lch1.H = lch2.H
+  } else if lch2.C < okLchAchromatic {
+    lch2.H = lch1.H
+  }
+
+  return ColorOkLch{
+    lch1.L + t*(lch2.L-lch1.L),
+    lch1.C + t*(lch2.C-lch1.C),
+    interpHue(lch1.H, lch2.H, t)}.Color()
+}
+
+// Chroma below which OKLCH colors are considered gray.
+const okLchAchromatic = 1e-4
+
+// interpHue interpolates between two hues in [0..360] along the shorter arc.
+func interpHue(h1, h2, t float64) float64 {
+  d := h2 - h1
+  if d > 180.0 {
+    d -= 360.0
+  } else if d < -180.0 {
+    d += 360.0
+  }
+  return math.Mod(h1+t*d+360.0, 360.0)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 /// Helpers RGBA color
 ///////////////////////////////////////////////////////////////////////////////
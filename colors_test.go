@@ -19,3 +19,112 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
 
 package colorful
+
+import (
+  "image"
+  "image/color"
+  "image/draw"
+  "math"
+  "testing"
+)
+
+// Make sure Color implements color.Color.
+var _ color.Color = Color{}
+
+func TestRGBAPremultiplied(t *testing.T) {
+  r, g, b, a := Color{1.0, 0.5, 0.0, 0.5}.RGBA()
+  if a != 0x8000 || r != 0x8000 || g != 0x4000 || b != 0 {
+    t.Errorf("RGBA() of half transparent color is wrong: %x %x %x %x", r, g, b, a)
+  }
+
+  r, g, b, a = Color{1.0, 1.0, 1.0, 1.0}.RGBA()
+  if r != 0xffff || g != 0xffff || b != 0xffff || a != 0xffff {
+    t.Errorf("RGBA() of white is wrong: %x %x %x %x", r, g, b, a)
+  }
+}
+
+func TestRGBAString(t *testing.T) {
+  tests := []struct {
+    c    Color
+    want string
+  }{
+    {Color{1.0, 0.0, 0.0, 1.0}, "255, 0, 0, 1"},
+    {Color{0.0, 0.5, 1.0, 0.5}, "0, 128, 255, 0.5"},
+    {Color{0.2, 0.4, 0.6, 0.25}, "51, 102, 153, 0.25"},
+    {Color{0.0, 0.0, 0.0, 0.0}, "0, 0, 0, 0"},
+    {Color{0.0, 0.0, 0.0, 1.0 / 3.0}, "0, 0, 0, 0.3333333333333333"},
+  }
+  for _, tt := range tests {
+    if s := tt.c.RGBAString(); s != tt.want {
+      t.Errorf("%#v.RGBAString() = %q, want %q", tt.c, s, tt.want)
+    }
+  }
+}
+
+func TestMakeColor(t *testing.T) {
+  c, ok := MakeColor(color.NRGBA{255, 128, 0, 128})
+  if ok {
+    t.Error("MakeColor reports a half transparent color as opaque")
+  }
+  if !c.AlmostEqualRgb(Color{1.0, 128.0 / 255.0, 0.0, 0.0}) || c.A < 0.5 || c.A > 0.51 {
+    t.Errorf("MakeColor did not un-premultiply correctly: %v", c)
+  }
+
+  c, ok = MakeColor(color.RGBA{0x12, 0x34, 0x56, 0xff})
+  if !ok || c.HexString() != "#123456" {
+    t.Errorf("MakeColor of an opaque color is wrong: %v (opaque %v)", c.HexString(), ok)
+  }
+
+  if c, ok = MakeColor(color.Transparent); ok || c.A != 0.0 {
+    t.Errorf("MakeColor of a transparent color is wrong: %v", c)
+  }
+}
+
+func TestModel(t *testing.T) {
+  img := image.NewRGBA(image.Rect(0, 0, 1, 1))
+  draw.Draw(img, img.Bounds(), image.NewUniform(Color{0.2, 0.4, 0.6, 1.0}), image.Point{}, draw.Src)
+
+  c := Model.Convert(img.At(0, 0)).(Color)
+  if !c.AlmostEqualRgb(Color{0.2, 0.4, 0.6, 1.0}) || c.A != 1.0 {
+    t.Errorf("Round trip through image.RGBA changed the color: %v", c)
+  }
+}
+
+func TestOkLab(t *testing.T) {
+  // Reference values from https://www.w3.org/TR/css-color-4/#specifying-oklab-oklch
+  lab := Color{1.0, 0.0, 0.0, 1.0}.OkLab()
+  if !lab.Eq(lab) || math.Abs(lab.L-0.62796) > 1e-4 || math.Abs(lab.A-0.22486) > 1e-4 || math.Abs(lab.B-0.12585) > 1e-4 {
+    t.Errorf("OKLab of red is wrong: %v", lab)
+  }
+  if lch := (Color{1.0, 0.0, 0.0, 1.0}).OkLch(); math.Abs(lch.C-0.25768) > 1e-4 || math.Abs(lch.H-29.234) > 1e-2 {
+    t.Errorf("OKLCH of red is wrong: %v", lch)
+  }
+
+  for _, hex := range []string{"#000000", "#ffffff", "#1a85ff", "#c65d06", "#33ff99"} {
+    c, _ := Hex(hex)
+    if got := c.OkLab().Color(); !got.AlmostEqualRgb(c) {
+      t.Errorf("OKLab round trip of %v gave %v", hex, got.HexString())
+    }
+    if got := c.OkLch().Color(); !got.AlmostEqualRgb(c) {
+      t.Errorf("OKLCH round trip of %v gave %v", hex, got.HexString())
+    }
+  }
+}
+
+func TestBlendOkLch(t *testing.T) {
+  c1 := ColorOkLch{0.6, 0.1, 350.0}.Color()
+  c2 := ColorOkLch{0.6, 0.1, 30.0}.Color()
+  if h := c1.BlendOkLch(c2, 0.5).OkLch().H; math.Abs(h-10.0) > 0.1 {
+    t.Errorf("BlendOkLch should wrap around the hue circle, got hue %v", h)
+  }
+
+  // Blending with white must not drag in white's arbitrary hue.
+  blue := ColorOkLch{0.5, 0.15, 260.0}.Color()
+  if h := blue.BlendOkLch(Color{1.0, 1.0, 1.0, 1.0}, 0.5).OkLch().H; math.Abs(h-260.0) > 0.5 {
+    t.Errorf("BlendOkLch with a gray changed the hue to %v", h)
+  }
+
+  if d := c1.DistanceOkLab(c1.BlendOkLab(c2, 0.0)); d > 1e-6 {
+    t.Errorf("BlendOkLab at 0 should give the first color, distance %v", d)
+  }
+}
@@ -0,0 +1,385 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+/// WCAG 2.x
+///////////////////////////////////////////////////////////////////////////////
+// https://www.w3.org/TR/WCAG21/#dfn-contrast-ratio
+
+// RelativeLuminance is the luminance of the color as defined by WCAG 2.1,
+// 0 for the darkest black and 1 for the lightest white. Alpha is ignored.
+func (c Color) RelativeLuminance() float64 {
+  lin := c.LinearRgb()
+  return 0.2126*lin.R + 0.7152*lin.G + 0.0722*lin.B
+}
+
+// Over composites the color on top of bg using its alpha, like a browser does.
+func (c Color) Over(bg Color) Color {
+  a := c.A + bg.A*(1.0-c.A)
+  if a == 0.0 {
+    return Color{0.0, 0.0, 0.0, 0.0}
+  }
+  blend := func(fg, bgv float64) float64 {
+    return (fg*c.A + bgv*bg.A*(1.0-c.A)) / a
+  }
+  return Color{blend(c.R, bg.R), blend(c.G, bg.G), blend(c.B, bg.B), a}
+}
+
+// opaquePair resolves the alpha of a text and background color: the background
+// is put on white and the text on the background.
+func opaquePair(text, bg Color) (Color, Color) {
+  if bg.A < 1.0 {
+    bg = bg.Over(Color{1.0, 1.0, 1.0, 1.0})
+  }
+  if text.A < 1.0 {
+    text = text.Over(bg)
+  }
+  return text, bg
+}
+
+// ContrastRatio is the WCAG 2.1 contrast ratio of the color as text on the
+// given background, from 1 (no contrast) to 21 (black on white).
+// A transparent text is composited over the background, a transparent
+// background over white.
+func (c Color) ContrastRatio(bg Color) float64 {
+  text, bg := opaquePair(c, bg)
+  l1 := text.RelativeLuminance()
+  l2 := bg.RelativeLuminance()
+  return (math.Max(l1, l2) + 0.05) / (math.Min(l1, l2) + 0.05)
+}
+
+// The conformance levels of WCAG 2.1 for contrast.
+type WCAGLevel int
+
+const (
+  WCAGFail WCAGLevel = iota
+  WCAGAA
+  WCAGAAA
+)
+
+func (l WCAGLevel) String() string {
+  switch l {
+  case WCAGAA:
+    return "AA"
+  case WCAGAAA:
+    return "AAA"
+  }
+  return "Fail"
+}
+
+// The minimum contrast ratios for the levels, see
+// https://www.w3.org/TR/WCAG21/#contrast-minimum and #contrast-enhanced.
+// Large text is at least 18pt, or 14pt bold.
+const (
+  WCAGRatioAA       = 4.5
+  WCAGRatioAAA      = 7.0
+  WCAGRatioAALarge  = 3.0
+  WCAGRatioAAALarge = 4.5
+)
+
+// WCAGLevel returns the highest level the color as text on bg conforms to.
+func (c Color) WCAGLevel(bg Color, largeText bool) WCAGLevel {
+  ratio := c.ContrastRatio(bg)
+  aa, aaa := WCAGRatioAA, WCAGRatioAAA
+  if largeText {
+    aa, aaa = WCAGRatioAALarge, WCAGRatioAAALarge
+  }
+
+  switch {
+  case ratio >= aaa:
+    return WCAGAAA
+  case ratio >= aa:
+    return WCAGAA
+  }
+  return WCAGFail
+}
+
+// IsAA tells whether the color as text on bg has enough contrast for level AA.
+func (c Color) IsAA(bg Color, largeText bool) bool {
+  return c.WCAGLevel(bg, largeText) >= WCAGAA
+}
+
+// IsAAA tells whether the color as text on bg has enough contrast for level AAA.
+func (c Color) IsAAA(bg Color, largeText bool) bool {
+  return c.WCAGLevel(bg, largeText) >= WCAGAAA
+}
+
+///////////////////////////////////////////////////////////////////////////////
+/// APCA
+///////////////////////////////////////////////////////////////////////////////
+// The Accessible Perceptual Contrast Algorithm of the WCAG 3 draft, APCA-W3 0.0.98G-4g.
+// https://github.com/Myndex/apca-w3
+
+const (
+  apcaMainTRC   = 2.4
+  apcaNormBG    = 0.56
+  apcaNormTXT   = 0.57
+  apcaRevTXT    = 0.62
+  apcaRevBG     = 0.65
+  apcaBlkThrs   = 0.022
+  apcaBlkClmp   = 1.414
+  apcaScale     = 1.14
+  apcaLoOffset  = 0.027
+  apcaLoClip    = 0.1
+  apcaDeltaYMin = 0.0005
+)
+
+// apcaY is the screen luminance estimate of APCA, which uses a plain power
+// curve instead of the piecewise sRGB one of RelativeLuminance.
+func (c Color) apcaY() float64 {
+  return 0.2126729*math.Pow(c.R, apcaMainTRC) +
+    0.7151522*math.Pow(c.G, apcaMainTRC) +
+    0.0721750*math.Pow(c.B, apcaMainTRC)
+}
+
+// APCAContrast computes the APCA lightness contrast Lc of text on a background.
+// It is polarity aware: dark text on a light background gives a positive Lc
+// (up to about 106), light text on a dark background a negative one (down to
+// about -108). Transparent colors are composited as in ContrastRatio.
+func APCAContrast(text, bg Color) float64 {
+  text, bg = opaquePair(text, bg)
+  txtY := text.apcaY()
+  bgY := bg.apcaY()
+
+  // Soft clamp the black levels.
+  if txtY < apcaBlkThrs {
+    txtY += math.Pow(apcaBlkThrs-txtY, apcaBlkClmp)
+  }
+  if bgY < apcaBlkThrs {
+    bgY += math.Pow(apcaBlkThrs-bgY, apcaBlkClmp)
+  }
+
+  if math.Abs(bgY-txtY) < apcaDeltaYMin {
+    return 0.0
+  }
+
+  var lc float64
+  if bgY > txtY {
+    // Dark text on light background.
+    sapc := (math.Pow(bgY, apcaNormBG) - math.Pow(txtY, apcaNormTXT)) * apcaScale
+    if sapc >= apcaLoClip {
+      lc = sapc - apcaLoOffset
+    }
+  } else {
+    // Light text on dark background.
+    sapc := (math.Pow(bgY, apcaRevBG) - math.Pow(txtY, apcaRevTXT)) * apcaScale
+    if sapc <= -apcaLoClip {
+      lc = sapc + apcaLoOffset
+    }
+  }
+  return lc * 100.0
+}
+
+// The font weights of the columns of apcaFontSizes.
+var apcaFontWeights = []int{100, 200, 300, 400, 500, 600, 700, 800, 900}
+
+// The minimum font sizes in px by Lc (the first value) and font weight, the
+// fontLookupAPCA table of apca-w3. 999 means no text at all, 777 non-text only.
+var apcaFontSizes = [][10]float64{
+  {0, 999, 999, 999, 999, 999, 999, 999, 999, 999},
+  {10, 999, 999, 999, 999, 999, 999, 999, 999, 999},
+  {15, 777, 777, 777, 777, 777, 777, 777, 777, 777},
+  {20, 777, 777, 777, 777, 777, 777, 777, 777, 777},
+  {25, 777, 777, 777, 120, 120, 108, 96, 96, 96},
+  {30, 777, 777, 120, 108, 108, 96, 72, 72, 72},
+  {35, 777, 120, 108, 96, 72, 60, 48, 48, 48},
+  {40, 120, 108, 96, 60, 48, 42, 32, 32, 32},
+  {45, 108, 96, 72, 42, 32, 28, 24, 24, 24},
+  {50, 96, 72, 60, 32, 28, 24, 21, 21, 21},
+  {55, 80, 60, 48, 28, 24, 21, 18, 18, 18},
+  {60, 72, 48, 42, 24, 21, 18, 16, 16, 18},
+  {65, 68, 46, 32, 21.75, 19, 17, 15, 16, 18},
+  {70, 64, 44, 28, 19.5, 18, 16, 14.5, 16, 18},
+  {75, 60, 42, 24, 18, 16, 15, 14, 16, 18},
+  {80, 56, 38.25, 23, 17.25, 15.81, 14.81, 14, 16, 18},
+  {85, 52, 34.5, 22, 16.5, 15.625, 14.625, 14, 16, 18},
+  {90, 48, 32, 21, 16, 15.5, 14.5, 14, 16, 18},
+  {95, 45, 28, 19.5, 15.5, 15, 14, 13.5, 16, 18},
+  {100, 42, 26.5, 18.5, 15, 14.5, 13.5, 13, 16, 18},
+  {105, 39, 25, 18, 14.5, 14, 13, 12, 16, 18},
+  {110, 36, 24, 18, 14, 13, 12, 11, 16, 18},
+  {115, 34.5, 22.5, 17.25, 12.5, 11.875, 11.25, 10.625, 14.5, 16.5},
+  {120, 33, 21, 16.5, 11, 11, 11, 10, 13, 15},
+  {125, 32, 20, 16, 10, 10, 10, 10, 12, 14},
+}
+
+// apcaRow finds the sizes for the highest tabulated Lc not above |lc|.
+func apcaRow(lc float64) [10]float64 {
+  lc = math.Abs(lc)
+  row := apcaFontSizes[0]
+  for _, r := range apcaFontSizes {
+    if r[0] <= lc {
+      row = r
+    }
+  }
+  return row
+}
+
+// APCAMinFontSize returns the smallest font size in px that is readable with
+// the given Lc at a font weight (100 to 900, rounded down to a multiple of 100).
+// The second value is false if no text of that weight is readable at this Lc.
+func APCAMinFontSize(lc float64, weight int) (float64, bool) {
+  i := weight/100 - 1
+  if i < 0 {
+    i = 0
+  } else if i >= len(apcaFontWeights) {
+    i = len(apcaFontWeights) - 1
+  }
+
+  size := apcaRow(lc)[i+1]
+  if size >= 777 {
+    return 0.0, false
+  }
+  return size, true
+}
+
+// APCAMinFontWeight returns the lightest font weight that is readable with
+// the given Lc at a font size in px.
+// The second value is false if no weight is readable at this size and Lc.
+func APCAMinFontWeight(lc, size float64) (int, bool) {
+  row := apcaRow(lc)
+  for i, weight := range apcaFontWeights {
+    if row[i+1] < 777 && row[i+1] <= size {
+      return weight, true
+    }
+  }
+  return 0, false
+}
+
+///////////////////////////////////////////////////////////////////////////////
+/// Contrast enforcement
+///////////////////////////////////////////////////////////////////////////////
+
+// hclInGamut gives the color with the hue and lightness of hcl and as much of
+// its chroma as fits into sRGB.
+func hclInGamut(hcl ColorHcl) Color {
+  return ColorHcl{hcl.H, hclMaxChroma(hcl), hcl.L}.Color().Clamped()
+}
+
+// hclMaxChroma is the chroma of hcl, or less if that doesn't fit into sRGB.
+func hclMaxChroma(hcl ColorHcl) float64 {
+  if hcl.Color().IsValid() {
+    return hcl.C
+  }
+  lo, hi := 0.0, hcl.C
+  for i := 0; i < 20; i++ {
+    mid := (lo + hi) / 2.0
+    if (ColorHcl{hcl.H, mid, hcl.L}).Color().IsValid() {
+      lo = mid
+    } else {
+      hi = mid
+    }
+  }
+  return lo
+}
+
+// ensure returns the color closest in lightness to c which passes, keeping the
+// hue and as much chroma as possible. If no lightness passes, it returns black
+// or white, whichever scores higher.
+func (c Color) ensure(pass func(Color) bool, score func(Color) float64) Color {
+  if pass(c) {
+    return c
+  }
+
+  hcl := c.Hcl()
+  at := func(l float64) Color {
+    col := hclInGamut(ColorHcl{hcl.H, hcl.C, l})
+    col.A = c.A
+    return col
+  }
+
+  var best Color
+  bestDist := math.Inf(+1)
+  for _, end := range []float64{0.0, 1.0} {
+    if !pass(at(end)) {
+      continue
+    }
+    // Bisect between the failing original and the passing extreme.
+    fail, ok := hcl.L, end
+    for i := 0; i < 30; i++ {
+      mid := (fail + ok) / 2.0
+      if pass(at(mid)) {
+        ok = mid
+      } else {
+        fail = mid
+      }
+    }
+    if d := math.Abs(ok - hcl.L); d < bestDist {
+      best, bestDist = at(ok), d
+    }
+  }
+
+  if math.IsInf(bestDist, +1) {
+    black, white := at(0.0), at(1.0)
+    if score(black) >= score(white) {
+      return black
+    }
+    return white
+  }
+  return best
+}
+
+// EnsureContrast returns the color with the nearest HCL lightness to c that has
+// at least the given WCAG 2.1 contrast ratio on bg. The hue is preserved, and as
+// much chroma as the sRGB gamut allows. If the ratio can't be met at all, the
+// darkest or lightest version of the color, whichever has more contrast, is returned.
+func (c Color) EnsureContrast(bg Color, minRatio float64) Color {
+  ratio := func(col Color) float64 { return col.ContrastRatio(bg) }
+  return c.ensure(func(col Color) bool { return ratio(col) >= minRatio }, ratio)
+}
+
+// EnsureAPCA is like EnsureContrast for an APCA lightness contrast, it only
+// looks at the magnitude of Lc so both polarities are fine.
+func (c Color) EnsureAPCA(bg Color, minLc float64) Color {
+  lc := func(col Color) float64 { return math.Abs(APCAContrast(col, bg)) }
+  return c.ensure(func(col Color) bool { return lc(col) >= minLc }, lc)
+}
+
+// ensureBest adjusts every candidate and picks the one which had to change the least.
+func (s ColorSlice) ensureBest(adjust func(Color) Color) (best Color) {
+  mindist := math.Inf(+1)
+  for _, c := range s {
+    adjusted := adjust(c)
+    if d := c.DistanceCIEDE2000(adjusted); d < mindist {
+      mindist = d
+      best = adjusted
+    }
+  }
+  return
+}
+
+// EnsureContrast picks the candidate which needs the smallest change (in ΔE2000)
+// to reach the contrast ratio on bg and returns it adjusted as Color.EnsureContrast does.
+// A candidate that already has enough contrast is returned as is.
+func (s ColorSlice) EnsureContrast(bg Color, minRatio float64) Color {
+  return s.ensureBest(func(c Color) Color { return c.EnsureContrast(bg, minRatio) })
+}
+
+// EnsureAPCA is ColorSlice.EnsureContrast for an APCA lightness contrast.
+func (s ColorSlice) EnsureAPCA(bg Color, minLc float64) Color {
+  return s.ensureBest(func(c Color) Color { return c.EnsureAPCA(bg, minLc) })
+}
@@ -0,0 +1,160 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+var (
+  black = Color{0.0, 0.0, 0.0, 1.0}
+  white = Color{1.0, 1.0, 1.0, 1.0}
+)
+
+func TestContrastRatio(t *testing.T) {
+  vals := []struct {
+    text, bg string
+    ratio    float64
+  }{
+    {"#000000", "#ffffff", 21.0},
+    {"#ffffff", "#000000", 21.0},
+    {"#ffffff", "#ffffff", 1.0},
+    {"#777777", "#ffffff", 4.48},
+    {"#767676", "#ffffff", 4.54},
+    {"#0000ff", "#ffffff", 8.59},
+    {"#ff0000", "#ffffff", 4.00},
+    // Half transparent black on white is #7f7f7f.
+    {"#00000080", "#ffffff", 4.00},
+  }
+  for _, v := range vals {
+    text, _ := Hex(v.text)
+    bg, _ := Hex(v.bg)
+    if r := text.ContrastRatio(bg); math.Abs(r-v.ratio) > 0.01 {
+      t.Errorf("Contrast of %v on %v is %.2f, want %.2f", v.text, v.bg, r, v.ratio)
+    }
+  }
+
+  if l := white.RelativeLuminance(); l != 1.0 {
+    t.Errorf("Relative luminance of white is %v", l)
+  }
+}
+
+func TestWCAGLevel(t *testing.T) {
+  gray, _ := Hex("#767676")
+  lightGray, _ := Hex("#949494")
+
+  vals := []struct {
+    got, want WCAGLevel
+  }{
+    {black.WCAGLevel(white, false), WCAGAAA},
+    {gray.WCAGLevel(white, false), WCAGAA},
+    {gray.WCAGLevel(white, true), WCAGAAA},
+    {lightGray.WCAGLevel(white, false), WCAGFail},
+    {lightGray.WCAGLevel(white, true), WCAGAA},
+  }
+  for i, v := range vals {
+    if v.got != v.want {
+      t.Errorf("%v: got level %v, want %v", i, v.got, v.want)
+    }
+  }
+
+  if !gray.IsAA(white, false) || gray.IsAAA(white, false) {
+    t.Error("#767676 on white is AA but not AAA")
+  }
+}
+
+func TestAPCAContrast(t *testing.T) {
+  // Published values of the APCA-W3 reference implementation.
+  vals := []struct {
+    text, bg string
+    lc       float64
+  }{
+    {"#888888", "#ffffff", 63.056469930209424},
+    {"#ffffff", "#888888", -68.54146436644962},
+    {"#000000", "#aaaaaa", 58.146262578561334},
+    {"#aaaaaa", "#000000", -56.24113336839742},
+    {"#112233", "#ddeeff", 91.66830811481631},
+    {"#ddeeff", "#112233", -93.06770049484275},
+    {"#000000", "#ffffff", 106.04067321268862},
+    {"#ffffff", "#000000", -107.88473318309848},
+    {"#123456", "#123456", 0.0},
+  }
+  for _, v := range vals {
+    text, _ := Hex(v.text)
+    bg, _ := Hex(v.bg)
+    if lc := APCAContrast(text, bg); math.Abs(lc-v.lc) > 1e-3 {
+      t.Errorf("Lc of %v on %v is %v, want %v", v.text, v.bg, lc, v.lc)
+    }
+  }
+}
+
+func TestAPCAFontLookup(t *testing.T) {
+  if size, ok := APCAMinFontSize(75.0, 400); !ok || size != 18.0 {
+    t.Errorf("Minimum size for Lc 75 at weight 400 is %v (%v), want 18", size, ok)
+  }
+  if size, ok := APCAMinFontSize(-77.0, 700); !ok || size != 14.0 {
+    t.Errorf("Minimum size for Lc -77 at weight 700 is %v (%v), want 14", size, ok)
+  }
+  if _, ok := APCAMinFontSize(20.0, 400); ok {
+    t.Error("No text is readable at Lc 20")
+  }
+
+  if weight, ok := APCAMinFontWeight(60.0, 24.0); !ok || weight != 400 {
+    t.Errorf("Minimum weight for Lc 60 at 24px is %v (%v), want 400", weight, ok)
+  }
+  if _, ok := APCAMinFontWeight(45.0, 12.0); ok {
+    t.Error("12px text is not readable at Lc 45")
+  }
+}
+
+func TestEnsureContrast(t *testing.T) {
+  brand, _ := Hex("#ff8800")
+  for _, bg := range []Color{white, black, {0.5, 0.5, 0.5, 1.0}} {
+    for _, ratio := range []float64{3.0, 4.5, 7.0} {
+      c := brand.EnsureContrast(bg, ratio)
+      if !c.IsValid() {
+        t.Errorf("EnsureContrast gave an invalid color %v", c)
+      }
+      // Mid gray can't reach 7 with anything.
+      if r := c.ContrastRatio(bg); r < ratio && !(bg.R == 0.5 && ratio == 7.0) {
+        t.Errorf("EnsureContrast(%v, %v) gave %v with ratio %v", bg.HexString(), ratio, c.HexString(), r)
+      }
+      if math.Abs(c.Hcl().H-brand.Hcl().H) > 5.0 && c.Hcl().C > 0.05 {
+        t.Errorf("EnsureContrast changed the hue from %v to %v", brand.Hcl().H, c.Hcl().H)
+      }
+    }
+  }
+
+  // Nothing to do, nothing changes.
+  if c := black.EnsureContrast(white, 7.0); c != black {
+    t.Errorf("EnsureContrast changed a color with enough contrast to %v", c)
+  }
+
+  // Impossible, but as good as it gets.
+  if c := brand.EnsureContrast(Color{0.5, 0.5, 0.5, 1.0}, 7.0); c.ContrastRatio(Color{0.5, 0.5, 0.5, 1.0}) < 4.5 {
+    t.Errorf("EnsureContrast didn't pick the best extreme: %v", c.HexString())
+  }
+}
+
+func TestEnsureAPCA(t *testing.T) {
+  brand, _ := Hex("#3377ff")
+  for _, bg := range []Color{white, black} {
+    if c := brand.EnsureAPCA(bg, 75.0); math.Abs(APCAContrast(c, bg)) < 75.0 {
+      t.Errorf("EnsureAPCA on %v gave %v with Lc %v", bg.HexString(), c.HexString(), APCAContrast(c, bg))
+    }
+  }
+}
+
+func TestSliceEnsureContrast(t *testing.T) {
+  yellow, _ := Hex("#ffee00")
+  navy, _ := Hex("#002288")
+  if c := (ColorSlice{yellow, navy}).EnsureContrast(white, 4.5); c != navy {
+    t.Errorf("ColorSlice.EnsureContrast should pick navy which needs no change, got %v", c.HexString())
+  }
+  if c := (ColorSlice{yellow}).EnsureContrast(white, 4.5); c.ContrastRatio(white) < 4.5 {
+    t.Errorf("ColorSlice.EnsureContrast gave %v without enough contrast", c.HexString())
+  }
+}
@@ -0,0 +1,120 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+  "strconv"
+  "strings"
+)
+
+// Serialization to CSS Color Module Level 4 strings, which Parse understands.
+// The prec argument is the number of decimals of values in [0..100] or [0..255],
+// values in [0..1] like alpha or OKLab get two more. Trailing zeros are dropped.
+
+func cssNum(v float64, prec int) string {
+  s := strconv.FormatFloat(v, 'f', prec, 64)
+  if strings.IndexByte(s, '.') >= 0 {
+    s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+  }
+  if s == "-0" {
+    return "0"
+  }
+  return s
+}
+
+func cssHue(h float64, prec int) string {
+  h = math.Mod(h, 360.0)
+  if h < 0.0 {
+    h += 360.0
+  }
+  // Rounding may give 360 which is the same as 0.
+  if s := cssNum(h, prec); s != "360" {
+    return s
+  }
+  return "0"
+}
+
+func cssFunc(name string, alpha float64, prec int, args ...string) string {
+  s := name + "(" + strings.Join(args, " ")
+  if alpha < 1.0 {
+    s += " / " + cssNum(clamp01(alpha), prec+2)
+  }
+  return s + ")"
+}
+
+// CSS returns the color as rgb(r g b) or rgb(r g b / a) if it's transparent.
+func (c Color) CSS(prec int) string {
+  return cssFunc("rgb", c.A, prec,
+    cssNum(c.R*255.0, prec), cssNum(c.G*255.0, prec), cssNum(c.B*255.0, prec))
+}
+
+// OkLchCSS returns the color as oklch(l c h) with an optional alpha.
+func (c Color) OkLchCSS(prec int) string {
+  lch := c.OkLch()
+  return cssFunc("oklch", c.A, prec, cssLch(lch.L, lch.C, lch.H, prec+2, prec)...)
+}
+
+// cssLch formats the values of lch() and oklch(), the hue of grays is 0 instead of noise.
+func cssLch(l, c, h float64, prec, hprec int) []string {
+  cs := cssNum(c, prec)
+  if cs == "0" {
+    return []string{cssNum(l, prec), cs, "0"}
+  }
+  return []string{cssNum(l, prec), cs, cssHue(h, hprec)}
+}
+
+// CSS returns the color as hsl(h s% l%).
+func (c ColorHsl) CSS(prec int) string {
+  return cssFunc("hsl", 1.0, prec,
+    cssHue(c.H, prec), cssNum(c.S*100.0, prec)+"%", cssNum(c.L*100.0, prec)+"%")
+}
+
+// CSS returns the color as hwb(h w% b%).
+func (c ColorHwb) CSS(prec int) string {
+  return cssFunc("hwb", 1.0, prec,
+    cssHue(c.H, prec), cssNum(c.W*100.0, prec)+"%", cssNum(c.B*100.0, prec)+"%")
+}
+
+// CSS returns the color as lab(l a b). CSS Lab is relative to D50, so
+// the (D65) values are chromatically adapted first.
+func (c ColorLab) CSS(prec int) string {
+  lab := xyzToCssLab(c.Xyz())
+  return cssFunc("lab", 1.0, prec,
+    cssNum(lab.L*100.0, prec), cssNum(lab.A*100.0, prec), cssNum(lab.B*100.0, prec))
+}
+
+// CSS returns the color as lch(l c h), adapted to D50 like ColorLab.CSS.
+func (c ColorLch) CSS(prec int) string {
+  lch := xyzToCssLab(c.Lab().Xyz()).Lch()
+  return cssFunc("lch", 1.0, prec, cssLch(lch.L*100.0, lch.C*100.0, lch.H, prec, prec)...)
+}
+
+// CSS returns the color as oklab(l a b).
+func (c ColorOkLab) CSS(prec int) string {
+  return cssFunc("oklab", 1.0, prec, cssNum(c.L, prec+2), cssNum(c.A, prec+2), cssNum(c.B, prec+2))
+}
+
+// CSS returns the color as oklch(l c h).
+func (c ColorOkLch) CSS(prec int) string {
+  return cssFunc("oklch", 1.0, prec, cssLch(c.L, c.C, c.H, prec+2, prec)...)
+}
@@ -0,0 +1,49 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+
+package colorful
+
+import (
+  "testing"
+)
+
+func TestCSSStrings(t *testing.T) {
+  vals := []struct {
+    got, want string
+  }{
+    {Color{1.0, 0.5, 0.0, 1.0}.CSS(2), "rgb(255 127.5 0)"},
+    {Color{1.0, 0.5, 0.0, 1.0}.CSS(0), "rgb(255 128 0)"},
+    {Color{1.0, 0.0, 0.0, 0.5}.CSS(0), "rgb(255 0 0 / 0.5)"},
+    {Color{0.0, 0.0, 1.0, 1.0}.Hsl().CSS(1), "hsl(240 100% 50%)"},
+    {Color{0.2, 0.8, 0.2, 1.0}.Hwb().CSS(1), "hwb(120 20% 20%)"},
+    {Color{1.0, 1.0, 1.0, 1.0}.Lab().CSS(0), "lab(100 0 0)"},
+    {Color{1.0, 1.0, 1.0, 1.0}.OkLchCSS(2), "oklch(1 0 0)"},
+    {Color{1.0, 1.0, 1.0, 1.0}.Lch().CSS(1), "lch(100 0 0)"},
+  }
+  for _, tt := range vals {
+    if tt.got != tt.want {
+      t.Errorf("got %v, want %v", tt.got, tt.want)
+    }
+  }
+}
+
+// Everything we write needs to be read back as the same color.
+func TestCSSRoundTrip(t *testing.T) {
+  for _, hex := range []string{"#000000", "#ffffff", "#7d2329", "#c65d06", "#1a85ff", "#33ff99"} {
+    c, _ := Hex(hex)
+    c.A = 0.75
+    for _, css := range []string{c.CSS(2), c.OkLchCSS(2)} {
+      if p, err := Parse(css); err != nil || !almostEqualColor(p, c, 1.0/255.0) {
+        t.Errorf("%v => %v => %v (%v)", c, css, p, err)
+      }
+    }
+
+    c.A = 1.0
+    for _, css := range []string{c.Hsl().CSS(2), c.Hwb().CSS(2), c.Lab().CSS(2), c.Lch().CSS(2)} {
+      if p, err := Parse(css); err != nil || !almostEqualColor(p, c, 1.0/255.0) {
+        t.Errorf("%v => %v => %v (%v)", c, css, p, err)
+      }
+    }
+  }
+}
@@ -0,0 +1,83 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+)
+
+// Parameters of a Cubehelix colormap, which goes from dark to light with a
+// monotonic perceived brightness, while rotating through the hues.
+// D. A. Green, "A colour scheme for the display of astronomical intensity
+// images", Bull. Astr. Soc. India 39, 2011.
+type CubehelixSettings struct {
+  // The hue to start with: 0 is blue, 1 red and 2 green.
+  Start float64
+
+  // The number of rotations through blue, red and green from start to end,
+  // negative to go the other way.
+  Rotations float64
+
+  // The saturation of the hues, 0 for grayscale.
+  Hue float64
+
+  // Emphasizes the dark colors when above 1, the light ones below.
+  // 0 or less means 1.
+  Gamma float64
+
+  // The brightness at the start and at the end, in [0..1].
+  MinLightness float64
+  MaxLightness float64
+}
+
+// The original parameters of Cubehelix, purple to purple from black to white.
+var DefaultCubehelix = CubehelixSettings{
+  Start:        0.5,
+  Rotations:    -1.5,
+  Hue:          1.0,
+  Gamma:        1.0,
+  MinLightness: 0.0,
+  MaxLightness: 1.0,
+}
+
+// Cubehelix returns the Cubehelix colormap with the settings. Colors too
+// saturated for sRGB are clamped, which keeps their brightness close.
+func Cubehelix(settings CubehelixSettings) Scale {
+  return func(t float64) Color {
+    l := settings.MinLightness + clamp01(t)*(settings.MaxLightness-settings.MinLightness)
+    if settings.Gamma > 0.0 {
+      l = math.Pow(l, settings.Gamma)
+    }
+
+    // Deviation from gray, perpendicular to the gray axis.
+    amp := settings.Hue * l * (1.0 - l) / 2.0
+    phi := 2.0 * math.Pi * (settings.Start/3.0 + settings.Rotations*clamp01(t))
+    cos, sin := math.Cos(phi), math.Sin(phi)
+
+    return Color{
+      l + amp*(-0.14861*cos+1.78277*sin),
+      l + amp*(-0.29227*cos-0.90649*sin),
+      l + amp*(1.97294*cos),
+      1.0,
+    }.Clamped()
+  }
+}
@@ -0,0 +1,69 @@
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+func TestCubehelix(t *testing.T) {
+  sc := Cubehelix(DefaultCubehelix)
+  if got := sc(0.0); got != (Color{0, 0, 0, 1}) {
+    t.Errorf("Cubehelix starts at %v, want black", got)
+  }
+  if got := sc(1.0); got != (Color{1, 1, 1, 1}) {
+    t.Errorf("Cubehelix ends at %v, want white", got)
+  }
+
+  // Cos is -sqrt(3)/2, sin 1/2 and the amplitude 1/8 halfway.
+  if got, want := sc(0.5), (Color{0.627511, 0.474984, 0.286423, 1}); !almostEqualColor(got, want, 1e-6) {
+    t.Errorf("Cubehelix(0.5) = %v, want %v", got, want)
+  }
+
+  // Brightness is exactly the lightness where there's no clamping.
+  settings := DefaultCubehelix
+  settings.Hue = 0.5
+  sc = Cubehelix(settings)
+  for _, x := range []float64{0.1, 0.3, 0.6, 0.9} {
+    c := sc(x)
+    if got := 0.30*c.R + 0.59*c.G + 0.11*c.B; math.Abs(got-x) > 1e-4 {
+      t.Errorf("brightness at %v is %v", x, got)
+    }
+  }
+
+  // And the luminance grows monotonically, so it prints well in grayscale.
+  prev := -1.0
+  for i, c := range Cubehelix(DefaultCubehelix).Colors(64) {
+    if l := c.Lab().L; l < prev {
+      t.Errorf("L* drops to %v at color %v", l, i)
+    } else {
+      prev = l
+    }
+  }
+}
+
+func TestCubehelixSettings(t *testing.T) {
+  gray := DefaultCubehelix
+  gray.Hue = 0.0
+  gray.MinLightness, gray.MaxLightness = 0.2, 0.8
+  gray.Gamma = 2.0
+  for i, c := range Cubehelix(gray).Colors(4) {
+    l := math.Pow(0.2+0.2*float64(i), 2.0)
+    if want := (Color{l, l, l, 1}); !almostEqualColor(c, want, 1e-9) {
+      t.Errorf("color %v is %v, want %v", i, c, want)
+    }
+  }
+
+  // Without a gamma, brightness is linear instead of all white.
+  gray.Gamma = 0.0
+  if c := Cubehelix(gray)(0.5); !almostEqualColor(c, Color{0.5, 0.5, 0.5, 1}, 1e-9) {
+    t.Errorf("without gamma, the middle is %v", c)
+  }
+
+  g := Cubehelix(DefaultCubehelix).Gradient(32)
+  if len(g.Stops) != 32 || g.Space != GradientRGB {
+    t.Errorf("Gradient(32) has %v stops in %v", len(g.Stops), g.Space)
+  }
+  if got, want := g.At(0.5), Cubehelix(DefaultCubehelix)(0.5); !almostEqualColor(got, want, 0.02) {
+    t.Errorf("Gradient(32).At(0.5) = %v, want %v", got, want)
+  }
+}
@@ -0,0 +1,470 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "image"
+  "image/color"
+  "math"
+)
+
+// Simulation of color vision deficiencies (CVD), i.e. color blindness.
+// Everything is done in linear RGB, as the models are defined there.
+
+// CVDKind is the kind of color vision deficiency, by the missing or anomalous cone.
+type CVDKind int
+
+const (
+  Protan CVDKind = iota // Red, L cone
+  Deutan                // Green, M cone
+  Tritan                // Blue, S cone
+)
+
+func (k CVDKind) String() string {
+  switch k {
+  case Protan:
+    return "protan"
+  case Deutan:
+    return "deutan"
+  case Tritan:
+    return "tritan"
+  }
+  return "unknown"
+}
+
+// CVDKinds lists all kinds of color vision deficiencies.
+var CVDKinds = []CVDKind{Protan, Deutan, Tritan}
+
+// CVDModel selects the simulation algorithm.
+type CVDModel int
+
+const (
+  // G. M. Machado, M. M. Oliveira and L. A. F. Fernandes, "A Physiologically-based
+  // Model for Simulation of Color Vision Deficiency", 2009, with the matrices
+  // published for each 0.1 of severity and interpolated in between.
+  Machado CVDModel = iota
+  // F. Viénot, H. Brettel and J. D. Mollon, "Digital video colourmaps for checking
+  // the legibility of displays by dichromats", 1999 for protan and deutan, and
+  // H. Brettel, F. Viénot and J. D. Mollon, "Computerized simulation of color
+  // appearance for dichromats", 1997 for tritan, which Viénot can't do well.
+  // The matrices are the ones of https://github.com/DaltonLens/libDaltonLens
+  BrettelVienot
+)
+
+type mat3 [3][3]float64
+
+func (m mat3) mul(c Color) Color {
+  return Color{
+    m[0][0]*c.R + m[0][1]*c.G + m[0][2]*c.B,
+    m[1][0]*c.R + m[1][1]*c.G + m[1][2]*c.B,
+    m[2][0]*c.R + m[2][1]*c.G + m[2][2]*c.B,
+    c.A,
+  }
+}
+
+// The matrices of Machado et al. for the severities 0.0, 0.1, ..., 1.0.
+// http://www.inf.ufrgs.br/~oliveira/pubs_files/CVD_Simulation/CVD_Simulation.html
+var machadoMatrices = map[CVDKind][11]mat3{
+  Protan: {
+    {
+      {1.000000, 0.000000, 0.000000},
+      {0.000000, 1.000000, 0.000000},
+      {0.000000, 0.000000, 1.000000},
+    },
+    {
+      {0.856167, 0.182038, -0.038205},
+      {0.029342, 0.955115, 0.015544},
+      {-0.002880, -0.001563, 1.004443},
+    },
+    {
+      {0.734766, 0.334872, -0.069637},
+      {0.051840, 0.919198, 0.028963},
+      {-0.004928, -0.004209, 1.009137},
+    },
+    {
+      {0.630323, 0.465641, -0.095964},
+      {0.069181, 0.890046, 0.040773},
+      {-0.006308, -0.007724, 1.014032},
+    },
+    {
+      {0.539009, 0.579343, -0.118352},
+      {0.082546, 0.866121, 0.051332},
+      {-0.007136, -0.011959, 1.019095},
+    },
+    {
+      {0.458064, 0.679578, -0.137642},
+      {0.092785, 0.846313, 0.060902},
+      {-0.007494, -0.016807, 1.024301},
+    },
+    {
+      {0.385450, 0.769005, -0.154455},
+      {0.100526, 0.829802, 0.069673},
+      {-0.007442, -0.022190, 1.029632},
+    },
+    {
+      {0.319627, 0.849633, -0.169261},
+      {0.106241, 0.815969, 0.077790},
+      {-0.007025, -0.028051, 1.035076},
+    },
+    {
+      {0.259411, 0.923008, -0.182420},
+      {0.110296, 0.804340, 0.085364},
+      {-0.006276, -0.034346, 1.040622},
+    },
+    {
+      {0.203876, 0.990338, -0.194214},
+      {0.112975, 0.794542, 0.092483},
+      {-0.005222, -0.041043, 1.046265},
+    },
+    {
+      {0.152286, 1.052583, -0.204868},
+      {0.114503, 0.786281, 0.099216},
+      {-0.003882, -0.048116, 1.051998},
+    },
+  },
+  Deutan: {
+    {
+      {1.000000, 0.000000, 0.000000},
+      {0.000000, 1.000000, 0.000000},
+      {0.000000, 0.000000, 1.000000},
+    },
+    {
+      {0.866435, 0.177704, -0.044139},
+      {0.049567, 0.939063, 0.011370},
+      {-0.003453, 0.007233, 0.996220},
+    },
+    {
+      {0.760729, 0.319078, -0.079807},
+      {0.090568, 0.889315, 0.020117},
+      {-0.006027, 0.013325, 0.992702},
+    },
+    {
+      {0.675425, 0.433850, -0.109275},
+      {0.125303, 0.847755, 0.026942},
+      {-0.007950, 0.018572, 0.989378},
+    },
+    {
+      {0.605511, 0.528560, -0.134071},
+      {0.155318, 0.812366, 0.032316},
+      {-0.009376, 0.023176, 0.986200},
+    },
+    {
+      {0.547494, 0.607765, -0.155259},
+      {0.181692, 0.781742, 0.036566},
+      {-0.010410, 0.027275, 0.983136},
+    },
+    {
+      {0.498864, 0.674741, -0.173604},
+      {0.205199, 0.754872, 0.039929},
+      {-0.011131, 0.030969, 0.980162},
+    },
+    {
+      {0.457771, 0.731899, -0.189670},
+      {0.226409, 0.731012, 0.042579},
+      {-0.011595, 0.034333, 0.977261},
+    },
+    {
+      {0.422823, 0.781057, -0.203881},
+      {0.245752, 0.709602, 0.044646},
+      {-0.011843, 0.037423, 0.974421},
+    },
+    {
+      {0.392952, 0.823610, -0.216562},
+      {0.263559, 0.690210, 0.046232},
+      {-0.011910, 0.040281, 0.971630},
+    },
+    {
+      {0.367322, 0.860646, -0.227968},
+      {0.280085, 0.672501, 0.047413},
+      {-0.011820, 0.042940, 0.968881},
+    },
+  },
+  Tritan: {
+    {
+      {1.000000, 0.000000, 0.000000},
+      {0.000000, 1.000000, 0.000000},
+      {0.000000, 0.000000, 1.000000},
+    },
+    {
+      {0.926670, 0.092514, -0.019184},
+      {0.021191, 0.964503, 0.014306},
+      {0.008437, 0.054813, 0.936750},
+    },
+    {
+      {0.895720, 0.133330, -0.029050},
+      {0.029997, 0.945400, 0.024603},
+      {0.013027, 0.104707, 0.882266},
+    },
+    {
+      {0.905871, 0.127791, -0.033662},
+      {0.026856, 0.941251, 0.031893},
+      {0.013410, 0.148296, 0.838294},
+    },
+    {
+      {0.948035, 0.089490, -0.037526},
+      {0.014364, 0.946792, 0.038844},
+      {0.010853, 0.193991, 0.795156},
+    },
+    {
+      {1.017277, 0.027029, -0.044306},
+      {-0.006113, 0.958479, 0.047634},
+      {0.006379, 0.248708, 0.744913},
+    },
+    {
+      {1.104996, -0.046633, -0.058363},
+      {-0.032137, 0.971635, 0.060503},
+      {0.001336, 0.317922, 0.680742},
+    },
+    {
+      {1.193214, -0.109812, -0.083402},
+      {-0.058496, 0.979410, 0.079086},
+      {-0.002346, 0.403492, 0.598854},
+    },
+    {
+      {1.257728, -0.139648, -0.118081},
+      {-0.078003, 0.975409, 0.102594},
+      {-0.003316, 0.501214, 0.502102},
+    },
+    {
+      {1.278864, -0.125333, -0.153531},
+      {-0.084748, 0.957674, 0.127074},
+      {-0.000989, 0.601151, 0.399838},
+    },
+    {
+      {1.255528, -0.076749, -0.178779},
+      {-0.078411, 0.930809, 0.147602},
+      {0.004733, 0.691367, 0.303900},
+    },
+  },
+}
+
+var vienotMatrices = map[CVDKind]mat3{
+  Protan: {
+    {0.11238, 0.88762, 0.00000},
+    {0.11238, 0.88762, -0.00000},
+    {0.00401, -0.00401, 1.00000},
+  },
+  Deutan: {
+    {0.29275, 0.70725, 0.00000},
+    {0.29275, 0.70725, -0.00000},
+    {-0.02234, 0.02234, 1.00000},
+  },
+}
+
+// The two half-planes of the Brettel tritan projection and the normal of the
+// plane separating them.
+var (
+  brettelTritan1 = mat3{
+    {1.01277, 0.13548, -0.14826},
+    {-0.01243, 0.86812, 0.14431},
+    {0.07589, 0.80500, 0.11911},
+  }
+  brettelTritan2 = mat3{
+    {0.93678, 0.18979, -0.12657},
+    {0.06154, 0.81526, 0.12320},
+    {-0.37562, 1.12767, 0.24796},
+  }
+  brettelTritanNormal = [3]float64{0.03901, -0.02788, -0.01113}
+)
+
+// dichromat simulates the full deficiency on a linear RGB color.
+func dichromat(lin Color, kind CVDKind, model CVDModel) Color {
+  if model == Machado {
+    return machadoMatrices[kind][10].mul(lin)
+  }
+  if kind != Tritan {
+    return vienotMatrices[kind].mul(lin)
+  }
+
+  n := brettelTritanNormal
+  if n[0]*lin.R+n[1]*lin.G+n[2]*lin.B >= 0.0 {
+    return brettelTritan1.mul(lin)
+  }
+  return brettelTritan2.mul(lin)
+}
+
+// SimulateCVD shows how a color looks with a color vision deficiency of the
+// given kind, using the Machado model. The severity is in [0..1], where 1 is a
+// dichromat (protanopia, deuteranopia or tritanopia) and values in between
+// anomalous trichromats.
+func (c Color) SimulateCVD(kind CVDKind, severity float64) Color {
+  return c.SimulateCVDEx(kind, severity, Machado)
+}
+
+// SimulateCVDEx is SimulateCVD with a choice of the model. Brettel-Viénot
+// only knows dichromats, so lower severities blend linearly towards the color.
+func (c Color) SimulateCVDEx(kind CVDKind, severity float64, model CVDModel) Color {
+  severity = clamp01(severity)
+  lin := c.LinearRgb()
+  var sim Color
+  if model == Machado {
+    sim = machadoMatrix(kind, severity).mul(lin)
+  } else {
+    d := dichromat(lin, kind, model)
+    sim = Color{lin.R + severity*(d.R-lin.R), lin.G + severity*(d.G-lin.G), lin.B + severity*(d.B-lin.B), c.A}
+  }
+  return Color{delinearize(clamp01(sim.R)), delinearize(clamp01(sim.G)), delinearize(clamp01(sim.B)), c.A}
+}
+
+// machadoMatrix interpolates the matrices of Machado et al. elementwise
+// between the two published severities around the given one.
+func machadoMatrix(kind CVDKind, severity float64) (m mat3) {
+  s := clamp01(severity) * 10.0
+  i := int(math.Min(s, 9.0))
+  f := s - float64(i)
+  m0, m1 := machadoMatrices[kind][i], machadoMatrices[kind][i+1]
+  for r := 0; r < 3; r++ {
+    for c := 0; c < 3; c++ {
+      m[r][c] = m0[r][c] + f*(m1[r][c]-m0[r][c])
+    }
+  }
+  return m
+}
+
+// SimulateCVD simulates a color vision deficiency on all colors of the slice.
+func (s ColorSlice) SimulateCVD(kind CVDKind, severity float64) ColorSlice {
+  ret := make(ColorSlice, len(s))
+  for i, c := range s {
+    ret[i] = c.SimulateCVD(kind, severity)
+  }
+  return ret
+}
+
+// CVDDistances are the smallest CIEDE2000 distances between any two colors of a
+// palette, as seen with normal vision and the three kinds of dichromacy.
+type CVDDistances struct {
+  Normal, Protan, Deutan, Tritan float64
+}
+
+// Min is the worst case distance of all kinds of vision.
+func (d CVDDistances) Min() float64 {
+  return math.Min(math.Min(d.Normal, d.Protan), math.Min(d.Deutan, d.Tritan))
+}
+
+// minPairDistance is the smallest CIEDE2000 distance of any two colors in labs.
+func minPairDistance(labs []ColorLab) float64 {
+  mindist := math.Inf(+1)
+  for i := range labs {
+    for j := i + 1; j < len(labs); j++ {
+      mindist = math.Min(mindist, labs[i].DistCIEDE2000(labs[j]))
+    }
+  }
+  return mindist
+}
+
+func (s ColorSlice) labs() []ColorLab {
+  labs := make([]ColorLab, len(s))
+  for i, c := range s {
+    labs[i] = c.Lab()
+  }
+  return labs
+}
+
+// MinDistanceCVD reports how distinguishable the colors of a palette stay for
+// people with a color vision deficiency: the smallest CIEDE2000 distance
+// between any two colors, for normal vision and the full Machado simulations.
+// Palettes with less than two colors have infinite distances.
+func (s ColorSlice) MinDistanceCVD() CVDDistances {
+  return CVDDistances{
+    Normal: minPairDistance(s.labs()),
+    Protan: minPairDistance(s.SimulateCVD(Protan, 1.0).labs()),
+    Deutan: minPairDistance(s.SimulateCVD(Deutan, 1.0).labs()),
+    Tritan: minPairDistance(s.SimulateCVD(Tritan, 1.0).labs()),
+  }
+}
+
+// How the information lost to a deficiency is shifted into the channels that
+// are still perceived, after O. Fidaner, P. Lin and N. Ozguven, "Analysis of
+// Color Blindness", 2005: protans and deutans get the red-green error added to
+// green and blue, tritans the blue error to red and green.
+var daltonizeMatrices = map[CVDKind]mat3{
+  Protan: {
+    {0.0, 0.0, 0.0},
+    {0.7, 1.0, 0.0},
+    {0.7, 0.0, 1.0},
+  },
+  Deutan: {
+    {0.0, 0.0, 0.0},
+    {0.7, 1.0, 0.0},
+    {0.7, 0.0, 1.0},
+  },
+  Tritan: {
+    {1.0, 0.0, 0.7},
+    {0.0, 1.0, 0.7},
+    {0.0, 0.0, 0.0},
+  },
+}
+
+// Daltonize corrects a color for a color vision deficiency, so that differences
+// invisible to people with it are turned into differences they can see.
+func (c Color) Daltonize(kind CVDKind) Color {
+  lin := c.LinearRgb()
+  sim := dichromat(lin, kind, Machado)
+  shift := daltonizeMatrices[kind].mul(Color{lin.R - sim.R, lin.G - sim.G, lin.B - sim.B, 0.0})
+  return Color{
+    delinearize(clamp01(lin.R + shift.R)),
+    delinearize(clamp01(lin.G + shift.G)),
+    delinearize(clamp01(lin.B + shift.B)),
+    c.A,
+  }
+}
+
+// Daltonize corrects all colors of the slice for a color vision deficiency.
+func (s ColorSlice) Daltonize(kind CVDKind) ColorSlice {
+  ret := make(ColorSlice, len(s))
+  for i, c := range s {
+    ret[i] = c.Daltonize(kind)
+  }
+  return ret
+}
+
+func nrgba(c Color) color.NRGBA {
+  return color.NRGBA{
+    uint8(clamp01(c.R)*255.0 + 0.5),
+    uint8(clamp01(c.G)*255.0 + 0.5),
+    uint8(clamp01(c.B)*255.0 + 0.5),
+    uint8(clamp01(c.A)*255.0 + 0.5),
+  }
+}
+
+// DaltonizeImage corrects a whole image for a color vision deficiency and
+// returns the result as a new image with the same bounds.
+func DaltonizeImage(img image.Image, kind CVDKind) *image.NRGBA {
+  bounds := img.Bounds()
+  ret := image.NewNRGBA(bounds)
+
+  // Images usually have a lot of repeated colors, no need to convert them all.
+  cache := make(map[color.NRGBA]color.NRGBA)
+  for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+    for x := bounds.Min.X; x < bounds.Max.X; x++ {
+      src := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+      dst, ok := cache[src]
+      if !ok {
+        c, _ := MakeColor(src)
+        dst = nrgba(c.Daltonize(kind))
+        cache[src] = dst
+      }
+      ret.SetNRGBA(x, y, dst)
+    }
+  }
+  return ret
+}
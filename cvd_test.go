@@ -0,0 +1,127 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+
+package colorful
+
+import (
+  "image"
+  "image/color"
+  "math"
+  "testing"
+)
+
+func TestSimulateCVD(t *testing.T) {
+  red := Color{1.0, 0.0, 0.0, 1.0}
+  green := Color{0.0, 0.5, 0.0, 1.0}
+  gray := Color{0.5, 0.5, 0.5, 0.8}
+
+  for _, model := range []CVDModel{Machado, BrettelVienot} {
+    for _, kind := range CVDKinds {
+      // Grays look the same to everyone.
+      if c := gray.SimulateCVDEx(kind, 1.0, model); !c.AlmostEqualRgb(gray) || c.A != gray.A {
+        t.Errorf("%v simulation (model %v) changed gray to %v", kind, model, c)
+      }
+      if c := red.SimulateCVDEx(kind, 0.0, model); !c.AlmostEqualRgb(red) {
+        t.Errorf("%v simulation (model %v) with severity 0 changed red to %v", kind, model, c)
+      }
+      if c := red.SimulateCVDEx(kind, 1.0, model); !c.IsValid() {
+        t.Errorf("%v simulation (model %v) gave an invalid color %v", kind, model, c)
+      }
+    }
+
+    // The classic: red and green are hard to tell apart for protans and deutans.
+    normal := red.DistanceCIEDE2000(green)
+    for _, kind := range []CVDKind{Protan, Deutan} {
+      sim := red.SimulateCVDEx(kind, 1.0, model).DistanceCIEDE2000(green.SimulateCVDEx(kind, 1.0, model))
+      if sim > normal/2.0 {
+        t.Errorf("%v (model %v) should confuse red and green, distance %v vs %v", kind, model, sim, normal)
+      }
+    }
+  }
+}
+
+// Reference values from the published matrices of Machado et al.
+func TestSimulateCVDMachado(t *testing.T) {
+  red := Color{1.0, 0.0, 0.0, 1.0}
+  blue := Color{0.2, 0.6, 0.9, 1.0}
+  tests := []struct {
+    kind     CVDKind
+    severity float64
+    c        Color
+    want     Color
+  }{
+    {Protan, 0.6, red, Color{0.654152, 0.350075, 0.0, 1.0}},
+    {Protan, 0.6, blue, Color{0.404585, 0.603392, 0.908047, 1.0}},
+    {Protan, 1.0, red, Color{0.426608, 0.372654, 0.0, 1.0}},
+    {Protan, 1.0, blue, Color{0.460186, 0.611706, 0.912761, 1.0}},
+    {Deutan, 0.6, red, Color{0.734608, 0.490329, 0.0, 1.0}},
+    {Deutan, 0.6, blue, Color{0.340215, 0.564522, 0.896898, 1.0}},
+    {Deutan, 1.0, red, Color{0.640060, 0.565807, 0.0, 1.0}},
+    {Deutan, 1.0, blue, Color{0.360441, 0.547653, 0.894308, 1.0}},
+    {Tritan, 0.6, red, Color{1.0, 0.0, 0.017261, 1.0}},
+    {Tritan, 0.6, blue, Color{0.0, 0.631122, 0.819462, 1.0}},
+    {Tritan, 1.0, red, Color{1.0, 0.0, 0.058386, 1.0}},
+    {Tritan, 1.0, blue, Color{0.0, 0.672732, 0.708151, 1.0}},
+  }
+  for _, tt := range tests {
+    if got := tt.c.SimulateCVDEx(tt.kind, tt.severity, Machado); !almostEqualColor(got, tt.want, 1e-5) {
+      t.Errorf("%v simulation of %v at severity %v: got %v, want %v", tt.kind, tt.c, tt.severity, got, tt.want)
+    }
+  }
+
+  // Between two published severities the matrices are interpolated.
+  m := machadoMatrix(Deutan, 0.65)
+  lo, hi := machadoMatrices[Deutan][6], machadoMatrices[Deutan][7]
+  if want := (lo[0][0] + hi[0][0]) / 2.0; math.Abs(m[0][0]-want) > 1e-12 {
+    t.Errorf("Machado deutan matrix at 0.65: got m[0][0] = %v, want %v", m[0][0], want)
+  }
+}
+
+func TestMinDistanceCVD(t *testing.T) {
+  pal := ColorSlice{{1.0, 0.0, 0.0, 1.0}, {0.0, 0.5, 0.0, 1.0}, {0.0, 0.0, 1.0, 1.0}}
+  d := pal.MinDistanceCVD()
+  if d.Normal <= d.Deutan || d.Normal <= d.Protan {
+    t.Errorf("Red and green should be closer for protans and deutans: %+v", d)
+  }
+  if d.Min() != d.Deutan && d.Min() != d.Protan {
+    t.Errorf("The worst case should be protan or deutan: %+v", d)
+  }
+}
+
+func TestDaltonize(t *testing.T) {
+  red := Color{1.0, 0.0, 0.0, 1.0}
+  green := Color{0.0, 0.5, 0.0, 1.0}
+  gray := Color{0.5, 0.5, 0.5, 1.0}
+
+  for _, kind := range CVDKinds {
+    if c := gray.Daltonize(kind); !c.AlmostEqualRgb(gray) {
+      t.Errorf("Daltonizing gray for %v changed it to %v", kind, c)
+    }
+  }
+
+  // After correction, a deutan should see red and green further apart.
+  pal := ColorSlice{red, green}
+  before := pal.SimulateCVD(Deutan, 1.0)
+  after := pal.Daltonize(Deutan).SimulateCVD(Deutan, 1.0)
+  if after[0].DistanceCIEDE2000(after[1]) <= before[0].DistanceCIEDE2000(before[1]) {
+    t.Errorf("Daltonize didn't improve red/green for deutans: %v, %v", before, after)
+  }
+}
+
+func TestDaltonizeImage(t *testing.T) {
+  img := image.NewRGBA(image.Rect(2, 3, 4, 5))
+  img.Set(2, 3, color.RGBA{255, 0, 0, 255})
+  img.Set(3, 4, color.RGBA{0, 64, 0, 128})
+
+  out := DaltonizeImage(img, Protan)
+  if out.Bounds() != img.Bounds() {
+    t.Errorf("DaltonizeImage changed the bounds to %v", out.Bounds())
+  }
+  if got, want := out.NRGBAAt(2, 3), nrgba(Color{1.0, 0.0, 0.0, 1.0}.Daltonize(Protan)); got != want {
+    t.Errorf("DaltonizeImage pixel is %v, want %v", got, want)
+  }
+  if a := out.NRGBAAt(3, 4).A; a != 128 {
+    t.Errorf("DaltonizeImage changed the alpha to %v", a)
+  }
+}
@@ -0,0 +1,188 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+)
+
+// Color difference formulas beyond the plain euclidean CIE76 of DistanceLab.
+// They all work on L*a*b* values scaled like the rest of the package, so the
+// results are the usual ΔE divided by 100, as with DistanceLab.
+// http://www.brucelindbloom.com/index.html?Eqn_DeltaE_CIE94.html
+
+// DistanceCIEDE2000 uses the CIEDE2000 formula, which fixes the non-uniformity
+// of DistanceLab in the blues and saturated colors. Use this if you can.
+func (c1 Color) DistanceCIEDE2000(c2 Color) float64 {
+  return c1.Lab().DistCIEDE2000(c2.Lab())
+}
+
+// DistanceCIEDE2000Ex is DistanceCIEDE2000 with the parametric factors for
+// lightness, chroma and hue. The defaults are all 1, textiles use kl = 2.
+func (c1 Color) DistanceCIEDE2000Ex(c2 Color, kl, kc, kh float64) float64 {
+  return c1.Lab().DistCIEDE2000Ex(c2.Lab(), kl, kc, kh)
+}
+
+func (lab1 ColorLab) DistCIEDE2000(lab2 ColorLab) float64 {
+  return lab1.DistCIEDE2000Ex(lab2, 1.0, 1.0, 1.0)
+}
+
+// http://www2.ece.rochester.edu/~gsharma/ciede2000/ciede2000noteCRNA.pdf
+func (lab1 ColorLab) DistCIEDE2000Ex(lab2 ColorLab, kl, kc, kh float64) float64 {
+  // The formula is defined on the usual L* in [0..100] scale.
+  l1, a1, b1 := lab1.L*100.0, lab1.A*100.0, lab1.B*100.0
+  l2, a2, b2 := lab2.L*100.0, lab2.A*100.0, lab2.B*100.0
+
+  cab := (math.Hypot(a1, b1) + math.Hypot(a2, b2)) / 2.0
+  g := 0.5 * (1.0 - math.Sqrt(math.Pow(cab, 7.0)/(math.Pow(cab, 7.0)+math.Pow(25.0, 7.0))))
+  ap1 := (1.0 + g) * a1
+  ap2 := (1.0 + g) * a2
+  cp1 := math.Hypot(ap1, b1)
+  cp2 := math.Hypot(ap2, b2)
+
+  hp1 := 0.0
+  if ap1 != 0.0 || b1 != 0.0 {
+    hp1 = math.Mod(math.Atan2(b1, ap1)*180.0/math.Pi+360.0, 360.0)
+  }
+  hp2 := 0.0
+  if ap2 != 0.0 || b2 != 0.0 {
+    hp2 = math.Mod(math.Atan2(b2, ap2)*180.0/math.Pi+360.0, 360.0)
+  }
+
+  deltaLp := l2 - l1
+  deltaCp := cp2 - cp1
+  dhp := 0.0
+  if cp1*cp2 != 0.0 {
+    dhp = hp2 - hp1
+    if dhp > 180.0 {
+      dhp -= 360.0
+    } else if dhp < -180.0 {
+      dhp += 360.0
+    }
+  }
+  deltaHp := 2.0 * math.Sqrt(cp1*cp2) * math.Sin(dhp/2.0*math.Pi/180.0)
+
+  meanLp := (l1 + l2) / 2.0
+  meanCp := (cp1 + cp2) / 2.0
+  meanHp := hp1 + hp2
+  if cp1*cp2 != 0.0 {
+    if math.Abs(hp1-hp2) <= 180.0 {
+      meanHp /= 2.0
+    } else if meanHp < 360.0 {
+      meanHp = (meanHp + 360.0) / 2.0
+    } else {
+      meanHp = (meanHp - 360.0) / 2.0
+    }
+  }
+
+  deg := math.Pi / 180.0
+  t := 1.0 - 0.17*math.Cos((meanHp-30.0)*deg) + 0.24*math.Cos(2.0*meanHp*deg) +
+    0.32*math.Cos((3.0*meanHp+6.0)*deg) - 0.2*math.Cos((4.0*meanHp-63.0)*deg)
+  deltaTheta := 30.0 * math.Exp(-sq((meanHp-275.0)/25.0))
+  rc := 2.0 * math.Sqrt(math.Pow(meanCp, 7.0)/(math.Pow(meanCp, 7.0)+math.Pow(25.0, 7.0)))
+  sl := 1.0 + (0.015*sq(meanLp-50.0))/math.Sqrt(20.0+sq(meanLp-50.0))
+  sc := 1.0 + 0.045*meanCp
+  sh := 1.0 + 0.015*meanCp*t
+  rt := -math.Sin(2.0*deltaTheta*deg) * rc
+
+  dl := deltaLp / (kl * sl)
+  dc := deltaCp / (kc * sc)
+  dh := deltaHp / (kh * sh)
+  return math.Sqrt(sq(dl)+sq(dc)+sq(dh)+rt*dc*dh) / 100.0
+}
+
+// The application specific weights of CIE94.
+type CIE94Application struct {
+  KL, K1, K2 float64
+}
+
+var (
+  CIE94GraphicArts = CIE94Application{KL: 1.0, K1: 0.045, K2: 0.015}
+  CIE94Textiles    = CIE94Application{KL: 2.0, K1: 0.048, K2: 0.014}
+)
+
+// DistanceCIE94 uses the CIE94 formula with the graphic arts weights.
+// Note that CIE94 is not symmetric, c1 is the reference color.
+func (c1 Color) DistanceCIE94(c2 Color) float64 {
+  return c1.Lab().DistCIE94(c2.Lab())
+}
+
+func (c1 Color) DistanceCIE94Ex(c2 Color, app CIE94Application) float64 {
+  return c1.Lab().DistCIE94Ex(c2.Lab(), app)
+}
+
+func (lab1 ColorLab) DistCIE94(lab2 ColorLab) float64 {
+  return lab1.DistCIE94Ex(lab2, CIE94GraphicArts)
+}
+
+func (lab1 ColorLab) DistCIE94Ex(lab2 ColorLab, app CIE94Application) float64 {
+  l1, a1, b1 := lab1.L*100.0, lab1.A*100.0, lab1.B*100.0
+  l2, a2, b2 := lab2.L*100.0, lab2.A*100.0, lab2.B*100.0
+
+  c1 := math.Hypot(a1, b1)
+  c2 := math.Hypot(a2, b2)
+  deltaL := l1 - l2
+  deltaC := c1 - c2
+  // Rounding may make this slightly negative.
+  deltaH2 := math.Max(0.0, sq(a1-a2)+sq(b1-b2)-sq(deltaC))
+
+  sc := 1.0 + app.K1*c1
+  sh := 1.0 + app.K2*c1
+  return math.Sqrt(sq(deltaL/app.KL)+sq(deltaC/sc)+deltaH2/sq(sh)) / 100.0
+}
+
+// DistanceCMC uses the CMC l:c formula of the Colour Measurement Committee.
+// Use l = 2, c = 1 for acceptability and l = c = 1 for perceptibility.
+// Note that CMC is not symmetric, c1 is the reference color.
+func (c1 Color) DistanceCMC(c2 Color, l, c float64) float64 {
+  return c1.Lab().DistCMC(c2.Lab(), l, c)
+}
+
+// http://www.brucelindbloom.com/index.html?Eqn_DeltaE_CMC.html
+func (lab1 ColorLab) DistCMC(lab2 ColorLab, l, c float64) float64 {
+  l1, a1, b1 := lab1.L*100.0, lab1.A*100.0, lab1.B*100.0
+  l2, a2, b2 := lab2.L*100.0, lab2.A*100.0, lab2.B*100.0
+
+  c1 := math.Hypot(a1, b1)
+  c2 := math.Hypot(a2, b2)
+  deltaL := l1 - l2
+  deltaC := c1 - c2
+  deltaH2 := math.Max(0.0, sq(a1-a2)+sq(b1-b2)-sq(deltaC))
+
+  h1 := math.Mod(math.Atan2(b1, a1)*180.0/math.Pi+360.0, 360.0)
+  var t float64
+  if 164.0 <= h1 && h1 <= 345.0 {
+    t = 0.56 + math.Abs(0.2*math.Cos((h1+168.0)*math.Pi/180.0))
+  } else {
+    t = 0.36 + math.Abs(0.4*math.Cos((h1+35.0)*math.Pi/180.0))
+  }
+  f := math.Sqrt(math.Pow(c1, 4.0) / (math.Pow(c1, 4.0) + 1900.0))
+
+  sl := 0.511
+  if l1 >= 16.0 {
+    sl = 0.040975 * l1 / (1.0 + 0.01765*l1)
+  }
+  sc := 0.0638*c1/(1.0+0.0131*c1) + 0.638
+  sh := sc * (f*t + 1.0 - f)
+
+  return math.Sqrt(sq(deltaL/(l*sl))+sq(deltaC/(c*sc))+deltaH2/sq(sh)) / 100.0
+}
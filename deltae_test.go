@@ -0,0 +1,89 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+// The test data of G. Sharma, W. Wu and E. N. Dalal, "The CIEDE2000
+// color-difference formula: Implementation notes, supplementary test data
+// and mathematical observations", 2005. L1 a1 b1, L2 a2 b2, ΔE00.
+var ciede2000Vals = [][7]float64{
+  {50.0000, 2.6772, -79.7751, 50.0000, 0.0000, -82.7485, 2.0425},
+  {50.0000, 3.1571, -77.2803, 50.0000, 0.0000, -82.7485, 2.8615},
+  {50.0000, 2.8361, -74.0200, 50.0000, 0.0000, -82.7485, 3.4412},
+  {50.0000, -1.3802, -84.2814, 50.0000, 0.0000, -82.7485, 1.0000},
+  {50.0000, -1.1848, -84.8006, 50.0000, 0.0000, -82.7485, 1.0000},
+  {50.0000, -0.9009, -85.5211, 50.0000, 0.0000, -82.7485, 1.0000},
+  {50.0000, 0.0000, 0.0000, 50.0000, -1.0000, 2.0000, 2.3669},
+  {50.0000, -1.0000, 2.0000, 50.0000, 0.0000, 0.0000, 2.3669},
+  {50.0000, 2.4900, -0.0010, 50.0000, -2.4900, 0.0009, 7.1792},
+  {50.0000, 2.4900, -0.0010, 50.0000, -2.4900, 0.0010, 7.1792},
+  {50.0000, 2.4900, -0.0010, 50.0000, -2.4900, 0.0011, 7.2195},
+  {50.0000, 2.4900, -0.0010, 50.0000, -2.4900, 0.0012, 7.2195},
+  {50.0000, -0.0010, 2.4900, 50.0000, 0.0009, -2.4900, 4.8045},
+  {50.0000, -0.0010, 2.4900, 50.0000, 0.0010, -2.4900, 4.8045},
+  {50.0000, -0.0010, 2.4900, 50.0000, 0.0011, -2.4900, 4.7461},
+  {50.0000, 2.5000, 0.0000, 50.0000, 0.0000, -2.5000, 4.3065},
+  {50.0000, 2.5000, 0.0000, 73.0000, 25.0000, -18.0000, 27.1492},
+  {50.0000, 2.5000, 0.0000, 61.0000, -5.0000, 29.0000, 22.8977},
+  {50.0000, 2.5000, 0.0000, 56.0000, -27.0000, -3.0000, 31.9030},
+  {50.0000, 2.5000, 0.0000, 58.0000, 24.0000, 15.0000, 19.4535},
+  {50.0000, 2.5000, 0.0000, 50.0000, 3.1736, 0.5854, 1.0000},
+  {50.0000, 2.5000, 0.0000, 50.0000, 3.2972, 0.0000, 1.0000},
+  {50.0000, 2.5000, 0.0000, 50.0000, 1.8634, 0.5757, 1.0000},
+  {50.0000, 2.5000, 0.0000, 50.0000, 3.2592, 0.3350, 1.0000},
+  {60.2574, -34.0099, 36.2677, 60.4626, -34.1751, 39.4387, 1.2644},
+  {63.0109, -31.0961, -5.8663, 62.8187, -29.7946, -4.0864, 1.2630},
+  {61.2901, 3.7196, -5.3901, 61.4292, 2.2480, -4.9620, 1.8731},
+  {35.0831, -44.1164, 3.7933, 35.0232, -40.0716, 1.5901, 1.8645},
+  {22.7233, 20.0904, -46.6940, 23.0331, 14.9730, -42.5619, 2.0373},
+  {36.4612, 47.8580, 18.3852, 36.2715, 50.5065, 21.2231, 1.4146},
+  {90.8027, -2.0831, 1.4410, 91.1528, -1.6435, 0.0447, 1.4441},
+  {90.9257, -0.5406, -0.9208, 88.6381, -0.8985, -0.7239, 1.5381},
+  {6.7747, -0.2908, -2.4247, 5.8714, -0.0985, -2.2286, 0.6377},
+  {2.0776, 0.0795, -1.1350, 0.9033, -0.0636, -0.5514, 0.9082},
+}
+
+func TestCIEDE2000(t *testing.T) {
+  for i, v := range ciede2000Vals {
+    lab1 := ColorLab{v[0] / 100.0, v[1] / 100.0, v[2] / 100.0}
+    lab2 := ColorLab{v[3] / 100.0, v[4] / 100.0, v[5] / 100.0}
+    if d := lab1.DistCIEDE2000(lab2) * 100.0; math.Abs(d-v[6]) > 1e-4 {
+      t.Errorf("Pair %d: ΔE00 is %.4f, want %.4f", i+1, d, v[6])
+    }
+    // CIEDE2000 is symmetric.
+    if d := lab2.DistCIEDE2000(lab1) * 100.0; math.Abs(d-v[6]) > 1e-4 {
+      t.Errorf("Pair %d reversed: ΔE00 is %.4f, want %.4f", i+1, d, v[6])
+    }
+  }
+}
+
+func TestCIE94AndCMC(t *testing.T) {
+  // The accuracy test values of python-colormath.
+  lab1 := ColorLab{0.009, 0.163, -0.0222}
+  lab2 := ColorLab{0.007, 0.142, -0.018}
+  vals := []struct {
+    name      string
+    got, want float64
+  }{
+    {"CIE76", lab1.Dist(lab2), 2.151},
+    {"CIE94 graphic arts", lab1.DistCIE94(lab2), 1.249},
+    {"CIE94 textiles", lab1.DistCIE94Ex(lab2, CIE94Textiles), 1.204},
+    {"CMC 2:1", lab1.DistCMC(lab2, 2.0, 1.0), 1.443},
+    {"CIEDE2000", lab1.DistCIEDE2000(lab2), 1.523},
+  }
+  for _, v := range vals {
+    if math.Abs(v.got*100.0-v.want) > 1e-3 {
+      t.Errorf("%v is %.4f, want %.4f", v.name, v.got*100.0, v.want)
+    }
+  }
+
+  if d := (Color{1.0, 0.0, 0.0, 1.0}).DistanceCIEDE2000(Color{1.0, 0.0, 0.0, 1.0}); d != 0.0 {
+    t.Errorf("Distance of a color to itself is %v", d)
+  }
+}
@@ -0,0 +1,61 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math/rand"
+)
+
+// Generator creates random colors and palettes from its own source of randomness,
+// so that the same seed always gives the same colors. The zero Generator uses the
+// global math/rand functions, which is what the package level functions do.
+// A Generator is not safe for concurrent use, give each goroutine its own.
+type Generator struct {
+  Rand *rand.Rand
+}
+
+// NewGenerator creates a Generator drawing from the given source.
+func NewGenerator(src rand.Source) *Generator {
+  return &Generator{rand.New(src)}
+}
+
+// NewSeededGenerator creates a Generator which always produces the same colors for the same seed.
+func NewSeededGenerator(seed int64) *Generator {
+  return NewGenerator(rand.NewSource(seed))
+}
+
+// The Generator used by the package level functions.
+var defaultGenerator = &Generator{}
+
+func (g *Generator) float64() float64 {
+  if g == nil || g.Rand == nil {
+    return rand.Float64()
+  }
+  return g.Rand.Float64()
+}
+
+func (g *Generator) intn(n int) int {
+  if g == nil || g.Rand == nil {
+    return rand.Intn(n)
+  }
+  return g.Rand.Intn(n)
+}
@@ -0,0 +1,59 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+
+package colorful
+
+import (
+  "math/rand"
+  "reflect"
+  "sync"
+  "testing"
+)
+
+func generate(g *Generator) []Color {
+  colors := []Color{g.FastWarmColor(), g.WarmColor(), g.FastHappyColor(), g.HappyColor()}
+  colors = append(colors, g.FastWarmPalette(3)...)
+  colors = append(colors, g.FastHappyPalette(3)...)
+  pal, _ := g.SoftPalette(4)
+  colors = append(colors, pal...)
+  pal, _ = g.WarmPalette(4)
+  return append(colors, pal...)
+}
+
+// The same seed needs to give the same colors, also across goroutines.
+func TestSeededGenerator(t *testing.T) {
+  want := generate(NewSeededGenerator(42))
+
+  var wg sync.WaitGroup
+  results := make([][]Color, 4)
+  for i := range results {
+    wg.Add(1)
+    go func(i int) {
+      defer wg.Done()
+      results[i] = generate(NewSeededGenerator(42))
+    }(i)
+  }
+  wg.Wait()
+
+  for i, got := range results {
+    if !reflect.DeepEqual(got, want) {
+      t.Errorf("Generator %v with the same seed gave different colors", i)
+    }
+  }
+
+  if reflect.DeepEqual(generate(NewSeededGenerator(43)), want) {
+    t.Error("Generators with different seeds gave the same colors")
+  }
+}
+
+func TestSettingsRand(t *testing.T) {
+  settings := SoftPaletteSettings{Iterations: 20, Rand: rand.New(rand.NewSource(7))}
+  pal1, _ := SoftPaletteEx(5, settings)
+  settings.Rand = rand.New(rand.NewSource(7))
+  pal2, _ := SoftPaletteEx(5, settings)
+
+  if !reflect.DeepEqual(pal1, pal2) {
+    t.Errorf("SoftPaletteEx with the same seed gave different palettes: %v and %v", pal1, pal2)
+  }
+}
@@ -0,0 +1,299 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+  "sort"
+)
+
+// GradientSpace is the color space a Gradient interpolates in.
+type GradientSpace int
+
+const (
+  GradientRGB GradientSpace = iota
+  GradientLinearRGB
+  GradientLab
+  GradientLuv
+  GradientHcl
+  GradientOkLab
+  GradientOkLch
+)
+
+// HueInterpolation is the way hues are interpolated in the cylindrical spaces,
+// as the hue-interpolation-method of CSS.
+// https://www.w3.org/TR/css-color-4/#hue-interpolation
+type HueInterpolation int
+
+const (
+  HueShorter HueInterpolation = iota
+  HueLonger
+  HueIncreasing
+  HueDecreasing
+)
+
+type GradientStop struct {
+  Color Color
+  Pos   float64
+}
+
+// Gradient interpolates between any number of color stops at arbitrary
+// positions. Before the first and after the last stop, its color is constant.
+// Alpha is interpolated premultiplied, like CSS gradients do.
+type Gradient struct {
+  Stops []GradientStop
+  Space GradientSpace
+  Hue   HueInterpolation
+}
+
+// NewGradient creates a gradient with the colors evenly spaced in [0..1],
+// interpolating in OKLab.
+func NewGradient(colors ...Color) *Gradient {
+  g := &Gradient{Stops: make([]GradientStop, len(colors)), Space: GradientOkLab}
+  for i, c := range colors {
+    g.Stops[i] = GradientStop{Color: c, Pos: 0.0}
+    if len(colors) > 1 {
+      g.Stops[i].Pos = float64(i) / float64(len(colors)-1)
+    }
+  }
+  return g
+}
+
+// AddStop adds a stop, keeping the stops sorted by position.
+func (g *Gradient) AddStop(pos float64, c Color) *Gradient {
+  i := sort.Search(len(g.Stops), func(i int) bool { return g.Stops[i].Pos > pos })
+  g.Stops = append(g.Stops, GradientStop{})
+  copy(g.Stops[i+1:], g.Stops[i:])
+  g.Stops[i] = GradientStop{Color: c, Pos: pos}
+  return g
+}
+
+// At returns the color of the gradient at position t, clipped to sRGB.
+func (g *Gradient) At(t float64) Color {
+  stops := g.Stops
+  switch {
+  case len(stops) == 0:
+    return Color{}
+  case t <= stops[0].Pos:
+    return stops[0].Color
+  case t >= stops[len(stops)-1].Pos:
+    return stops[len(stops)-1].Color
+  }
+
+  // The first stop after t, of which there is one since t is before the last.
+  i := sort.Search(len(stops), func(i int) bool { return stops[i].Pos > t })
+  s1, s2 := stops[i-1], stops[i]
+  return g.Space.blend(s1.Color, s2.Color, (t-s1.Pos)/(s2.Pos-s1.Pos), g.Hue)
+}
+
+// Colors samples n colors evenly from the first to the last stop, both included.
+func (g *Gradient) Colors(n int) ColorSlice {
+  if n <= 0 || len(g.Stops) == 0 {
+    return ColorSlice{}
+  }
+  colors := make(ColorSlice, n)
+  start, end := g.Stops[0].Pos, g.Stops[len(g.Stops)-1].Pos
+  for i := range colors {
+    t := start
+    if n > 1 {
+      t += (end - start) * float64(i) / float64(n-1)
+    }
+    colors[i] = g.At(t)
+  }
+  return colors
+}
+
+// Reversed returns a copy of the gradient running the other way, over the same
+// positions.
+func (g *Gradient) Reversed() *Gradient {
+  r := &Gradient{Stops: make([]GradientStop, len(g.Stops)), Space: g.Space, Hue: g.Hue}
+  // Going backwards, increasing hues decrease.
+  switch g.Hue {
+  case HueIncreasing:
+    r.Hue = HueDecreasing
+  case HueDecreasing:
+    r.Hue = HueIncreasing
+  }
+  if len(g.Stops) == 0 {
+    return r
+  }
+  start, end := g.Stops[0].Pos, g.Stops[len(g.Stops)-1].Pos
+  for i, stop := range g.Stops {
+    r.Stops[len(g.Stops)-1-i] = GradientStop{Color: stop.Color, Pos: start + end - stop.Pos}
+  }
+  return r
+}
+
+// Number of samples Uniform measures the gradient with.
+const uniformSamples = 256
+
+// Uniform returns a copy of the gradient reparametrized so that equal steps of
+// t are equal steps of the distance, e.g. Color.DistanceCIEDE2000 or
+// Color.DistanceOkLab. Its Colors are then perceptually equidistant.
+func (g *Gradient) Uniform(distance func(c1, c2 Color) float64) *Gradient {
+  if len(g.Stops) < 2 {
+    return &Gradient{Stops: append([]GradientStop{}, g.Stops...), Space: g.Space, Hue: g.Hue}
+  }
+
+  // Sample evenly and at the stops, so none of them is missed.
+  start, end := g.Stops[0].Pos, g.Stops[len(g.Stops)-1].Pos
+  ts := make([]float64, 0, uniformSamples+len(g.Stops))
+  for i := 0; i < uniformSamples; i++ {
+    ts = append(ts, start+(end-start)*float64(i)/float64(uniformSamples-1))
+  }
+  for _, stop := range g.Stops {
+    ts = append(ts, stop.Pos)
+  }
+  sort.Float64s(ts)
+
+  stops := make([]GradientStop, len(ts))
+  total := 0.0
+  for i, t := range ts {
+    stops[i].Color = g.At(t)
+    if i > 0 {
+      total += distance(stops[i-1].Color, stops[i].Color)
+    }
+    stops[i].Pos = total
+  }
+
+  // A gradient without any change is uniform already.
+  if total == 0.0 {
+    return &Gradient{Stops: append([]GradientStop{}, g.Stops...), Space: g.Space, Hue: g.Hue}
+  }
+
+  for i := range stops {
+    stops[i].Pos = start + (end-start)*stops[i].Pos/total
+  }
+  // The samples already follow the hue path, so they only need the shorter way
+  // between each other.
+  return &Gradient{Stops: stops, Space: g.Space, Hue: HueShorter}
+}
+
+// Uniform makes a gradient of the colors, interpolated in OKLab, in which
+// equal steps of t are equal steps of the distance. See Gradient.Uniform.
+func (s ColorSlice) Uniform(distance func(c1, c2 Color) float64) *Gradient {
+  return NewGradient(s...).Uniform(distance)
+}
+
+// Hue is the third value of the cylindrical spaces.
+func (space GradientSpace) hasHue() bool {
+  return space == GradientHcl || space == GradientOkLch
+}
+
+func (space GradientSpace) from(c Color) [3]float64 {
+  switch space {
+  case GradientLinearRGB:
+    lin := c.LinearRgb()
+    return [3]float64{lin.R, lin.G, lin.B}
+  case GradientLab:
+    lab := c.Lab()
+    return [3]float64{lab.L, lab.A, lab.B}
+  case GradientLuv:
+    luv := c.Luv()
+    return [3]float64{luv.L, luv.U, luv.V}
+  case GradientHcl:
+    hcl := c.Hcl()
+    return [3]float64{hcl.L, hcl.C, hcl.H}
+  case GradientOkLab:
+    lab := c.OkLab()
+    return [3]float64{lab.L, lab.A, lab.B}
+  case GradientOkLch:
+    lch := c.OkLch()
+    return [3]float64{lch.L, lch.C, lch.H}
+  }
+  return [3]float64{c.R, c.G, c.B}
+}
+
+func (space GradientSpace) to(v [3]float64) Color {
+  switch space {
+  case GradientLinearRGB:
+    return LinearRgb(v[0], v[1], v[2])
+  case GradientLab:
+    return ColorLab{v[0], v[1], v[2]}.Color()
+  case GradientLuv:
+    return ColorLuv{v[0], v[1], v[2]}.Color()
+  case GradientHcl:
+    return ColorHcl{v[2], v[1], v[0]}.Color()
+  case GradientOkLab:
+    return ColorOkLab{v[0], v[1], v[2]}.Color()
+  case GradientOkLch:
+    return ColorOkLch{v[0], v[1], v[2]}.Color()
+  }
+  return Color{v[0], v[1], v[2], 1.0}
+}
+
+// blend interpolates two colors in the space with premultiplied alpha.
+func (space GradientSpace) blend(c1, c2 Color, t float64, hue HueInterpolation) Color {
+  v1, v2 := space.from(c1), space.from(c2)
+
+  n := 3
+  if space.hasHue() {
+    n = 2
+
+    // The hue of grays is powerless, take the one of the other color instead.
+    if v1[1] < okLchAchromatic {
+      v1[2] = v2[2]
+    } else if v2[1] < okLchAchromatic {
+      v2[2] = v1[2]
+    }
+  }
+
+  a := c1.A + t*(c2.A-c1.A)
+  var v [3]float64
+  for i := 0; i < n; i++ {
+    v[i] = v1[i]*c1.A + t*(v2[i]*c2.A-v1[i]*c1.A)
+    if a != 0.0 {
+      v[i] /= a
+    }
+  }
+  if space.hasHue() {
+    v[2] = interpHueMethod(v1[2], v2[2], t, hue)
+  }
+
+  col := space.to(v).Clamped()
+  col.A = a
+  return col
+}
+
+// interpHueMethod interpolates between two hues in [0..360] as CSS does.
+func interpHueMethod(h1, h2, t float64, method HueInterpolation) float64 {
+  d := h2 - h1
+  switch method {
+  case HueShorter:
+    return interpHue(h1, h2, t)
+  case HueLonger:
+    if 0.0 < d && d < 180.0 {
+      h1 += 360.0
+    } else if -180.0 < d && d <= 0.0 {
+      h2 += 360.0
+    }
+  case HueIncreasing:
+    if h2 < h1 {
+      h2 += 360.0
+    }
+  case HueDecreasing:
+    if h1 < h2 {
+      h1 += 360.0
+    }
+  }
+  return math.Mod(h1+t*(h2-h1)+360.0, 360.0)
+}
@@ -0,0 +1,221 @@
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+func TestGradientAt(t *testing.T) {
+  red, green, blue := Color{1, 0, 0, 1}, Color{0, 1, 0, 1}, Color{0, 0, 1, 1}
+  g := &Gradient{Stops: []GradientStop{{red, 0.0}, {green, 0.25}, {blue, 1.0}}}
+
+  tests := []struct {
+    t    float64
+    want Color
+  }{
+    {-1.0, red},
+    {0.0, red},
+    {0.125, Color{0.5, 0.5, 0, 1}},
+    {0.25, green},
+    {0.625, Color{0, 0.5, 0.5, 1}},
+    {1.0, blue},
+    {2.0, blue},
+  }
+  for _, tt := range tests {
+    if got := g.At(tt.t); !almostEqualColor(got, tt.want, 1e-9) {
+      t.Errorf("At(%v) = %v, want %v", tt.t, got, tt.want)
+    }
+  }
+}
+
+func TestGradientSpaces(t *testing.T) {
+  c1, c2 := Color{0.99, 1, 0.8, 1}, Color{0.14, 0.16, 0.26, 1}
+  spaces := []struct {
+    space GradientSpace
+    blend func(c1, c2 Color, t float64) Color
+  }{
+    {GradientRGB, Color.BlendRgb},
+    {GradientLab, Color.BlendLab},
+    {GradientLuv, Color.BlendLuv},
+    {GradientHcl, Color.BlendHcl},
+    {GradientOkLab, Color.BlendOkLab},
+    {GradientOkLch, Color.BlendOkLch},
+  }
+  for _, s := range spaces {
+    g := &Gradient{Stops: []GradientStop{{c1, 0}, {c2, 1}}, Space: s.space}
+    for _, x := range []float64{0.0, 0.3, 0.5, 1.0} {
+      if got, want := g.At(x), s.blend(c1, c2, x).Clamped(); !almostEqualColor(got, want, 1e-6) {
+        t.Errorf("space %v: At(%v) = %v, want %v", s.space, x, got, want)
+      }
+    }
+  }
+
+  g := &Gradient{Stops: []GradientStop{{c1, 0}, {c2, 1}}, Space: GradientLinearRGB}
+  lin1, lin2 := c1.LinearRgb(), c2.LinearRgb()
+  want := LinearRgb((lin1.R+lin2.R)/2, (lin1.G+lin2.G)/2, (lin1.B+lin2.B)/2)
+  if got := g.At(0.5); !almostEqualColor(got, want, 1e-9) {
+    t.Errorf("linear RGB: At(0.5) = %v, want %v", got, want)
+  }
+}
+
+func TestGradientHueInterpolation(t *testing.T) {
+  c1 := ColorOkLch{0.7, 0.1, 30}.Color()
+  c2 := ColorOkLch{0.7, 0.1, 90}.Color()
+  h1, h2 := c1.OkLch().H, c2.OkLch().H
+
+  tests := []struct {
+    method HueInterpolation
+    from   Color
+    to     Color
+    want   float64
+  }{
+    {HueShorter, c1, c2, (h1 + h2) / 2},
+    {HueLonger, c1, c2, math.Mod((h1+h2+360)/2, 360)},
+    {HueIncreasing, c1, c2, (h1 + h2) / 2},
+    {HueDecreasing, c1, c2, math.Mod((h1+h2+360)/2, 360)},
+    {HueIncreasing, c2, c1, math.Mod((h1+h2+360)/2, 360)},
+    {HueDecreasing, c2, c1, (h1 + h2) / 2},
+  }
+  for _, tt := range tests {
+    g := &Gradient{Stops: []GradientStop{{tt.from, 0}, {tt.to, 1}}, Space: GradientOkLch, Hue: tt.method}
+    v := g.Space.from(g.Space.blend(tt.from, tt.to, 0.5, tt.method))
+    if math.Abs(v[2]-tt.want) > 1e-3 {
+      t.Errorf("method %v from %v to %v: hue %v, want %v", tt.method, tt.from.HexString(), tt.to.HexString(), v[2], tt.want)
+    }
+  }
+
+  // Gray takes the hue of the other color instead of passing through red.
+  g := &Gradient{Stops: []GradientStop{{Color{1, 1, 1, 1}, 0}, {Color{0, 0, 1, 1}, 1}}, Space: GradientOkLch}
+  if got, want := g.At(0.5).OkLch().H, (Color{0, 0, 1, 1}).OkLch().H; math.Abs(got-want) > 5.0 {
+    t.Errorf("white to blue: hue %v, want %v", got, want)
+  }
+}
+
+func TestGradientReversed(t *testing.T) {
+  c1 := ColorOkLch{0.7, 0.1, 30}.Color()
+  c2 := ColorOkLch{0.7, 0.1, 90}.Color()
+  for _, method := range []HueInterpolation{HueShorter, HueLonger, HueIncreasing, HueDecreasing} {
+    g := &Gradient{Stops: []GradientStop{{c1, 0}, {c2, 0.4}, {c1, 1}}, Space: GradientOkLch, Hue: method}
+    r := g.Reversed()
+    for _, x := range []float64{0.0, 0.1, 0.3, 0.5, 0.8, 1.0} {
+      if got, want := r.At(x), g.At(1.0-x); !almostEqualColor(got, want, 1e-9) {
+        t.Errorf("method %v: Reversed().At(%v) = %v, want %v", method, x, got, want)
+      }
+    }
+  }
+}
+
+func TestGradientPremultipliedAlpha(t *testing.T) {
+  // Transparent stops don't tint the gradient, whichever color they have.
+  g := &Gradient{Stops: []GradientStop{{Color{1, 0, 0, 1}, 0}, {Color{0, 0, 1, 0}, 1}}}
+  if got, want := g.At(0.5), (Color{1, 0, 0, 0.5}); !almostEqualColor(got, want, 1e-9) {
+    t.Errorf("At(0.5) = %v, want %v", got, want)
+  }
+
+  g = &Gradient{Stops: []GradientStop{{Color{1, 1, 1, 0.2}, 0}, {Color{0, 0, 0, 0.6}, 1}}}
+  // (0.2*1 + 0)/2 / 0.4
+  if got, want := g.At(0.5), (Color{0.25, 0.25, 0.25, 0.4}); !almostEqualColor(got, want, 1e-9) {
+    t.Errorf("At(0.5) = %v, want %v", got, want)
+  }
+}
+
+func TestGradientColors(t *testing.T) {
+  g := NewGradient(Color{0, 0, 0, 1}, Color{1, 1, 1, 1}).AddStop(0.5, Color{1, 0, 0, 1})
+  g.Space = GradientRGB
+
+  if len(g.Stops) != 3 || g.Stops[1].Pos != 0.5 {
+    t.Fatalf("AddStop didn't keep the stops sorted: %v", g.Stops)
+  }
+
+  colors := g.Colors(5)
+  want := ColorSlice{{0, 0, 0, 1}, {0.5, 0, 0, 1}, {1, 0, 0, 1}, {1, 0.5, 0.5, 1}, {1, 1, 1, 1}}
+  if len(colors) != len(want) {
+    t.Fatalf("got %v colors, want %v", len(colors), len(want))
+  }
+  for i := range want {
+    if !almostEqualColor(colors[i], want[i], 1e-9) {
+      t.Errorf("Colors(5)[%v] = %v, want %v", i, colors[i], want[i])
+    }
+  }
+
+  if n := len(g.Colors(0)); n != 0 {
+    t.Errorf("Colors(0) has %v colors", n)
+  }
+  if c := (&Gradient{}).At(0.5); c != (Color{}) {
+    t.Errorf("empty gradient At(0.5) = %v", c)
+  }
+}
+
+func TestPaletteTo(t *testing.T) {
+  from, to := Color{0.2, 0.4, 0.6, 1}, Color{1, 0, 0.6, 1}
+  colors := from.PaletteTo(to, 5)
+  if len(colors) != 5 {
+    t.Fatalf("got %v colors, want 5", len(colors))
+  }
+  if !almostEqualColor(colors[0], from, 1e-9) || !almostEqualColor(colors[4], to, 1e-9) {
+    t.Errorf("PaletteTo goes from %v to %v, want %v to %v", colors[0], colors[4], from, to)
+  }
+  if want := (Color{0.6, 0.2, 0.6, 1}); !almostEqualColor(colors[2], want, 1e-9) {
+    t.Errorf("middle of PaletteTo is %v, want %v", colors[2], want)
+  }
+}
+
+func TestGradientUniform(t *testing.T) {
+  tests := []struct {
+    name     string
+    g        *Gradient
+    distance func(c1, c2 Color) float64
+  }{
+    {"rgb black to white", &Gradient{Stops: []GradientStop{{Color{0, 0, 0, 1}, 0}, {Color{1, 1, 1, 1}, 1}}}, Color.DistanceCIEDE2000},
+    {"uneven stops", &Gradient{Stops: []GradientStop{{Color{0, 0, 0.5, 1}, 0}, {Color{1, 1, 0, 1}, 0.1}, {Color{1, 0, 0, 1}, 1}}, Space: GradientLab}, Color.DistanceCIEDE2000},
+    {"slice in oklab", ColorSlice{{0.1, 0.1, 0.4, 1}, {0.9, 0.9, 0.9, 1}, {0.8, 0.1, 0, 1}, {0.95, 0.9, 0.1, 1}}.Uniform(Color.DistanceOkLab), Color.DistanceOkLab},
+  }
+  for _, tt := range tests {
+    // Steps along the gradient, not straight across its bends.
+    colors := tt.g.Uniform(tt.distance).Colors(1001)
+    min, max := math.Inf(+1), 0.0
+    for i := 100; i < len(colors); i += 100 {
+      d := 0.0
+      for j := i - 99; j <= i; j++ {
+        d += tt.distance(colors[j-1], colors[j])
+      }
+      min, max = math.Min(min, d), math.Max(max, d)
+    }
+    if max/min > 1.05 {
+      t.Errorf("%v: steps range from %v to %v", tt.name, min, max)
+    }
+
+    if tt.g.Space == GradientRGB {
+      // Without bends, the direct steps are equal too.
+      colors = tt.g.Uniform(tt.distance).Colors(11)
+      min, max = math.Inf(+1), 0.0
+      for i := 1; i < len(colors); i++ {
+        d := tt.distance(colors[i-1], colors[i])
+        min, max = math.Min(min, d), math.Max(max, d)
+      }
+      if max/min > 1.05 {
+        t.Errorf("%v: direct steps range from %v to %v", tt.name, min, max)
+      }
+    }
+
+    // The ends don't move.
+    colors = tt.g.Colors(2)
+    uniform := tt.g.Uniform(tt.distance).Colors(2)
+    for i := range colors {
+      if !almostEqualColor(colors[i], uniform[i], 1e-9) {
+        t.Errorf("%v: end %v moved from %v to %v", tt.name, i, colors[i], uniform[i])
+      }
+    }
+  }
+
+  // The resampled gradient keeps the hue path of the original.
+  longer := &Gradient{Stops: []GradientStop{{ColorOkLch{0.7, 0.1, 30}.Color(), 0}, {ColorOkLch{0.7, 0.1, 90}.Color(), 1}}, Space: GradientOkLch, Hue: HueLonger}
+  if got, want := longer.Uniform(Color.DistanceOkLab).At(0.5).OkLch().H, longer.At(0.5).OkLch().H; math.Abs(got-want) > 5.0 {
+    t.Errorf("longer hue: Uniform At(0.5) has hue %v, want %v", got, want)
+  }
+
+  flat := NewGradient(Color{0.5, 0.5, 0.5, 1}, Color{0.5, 0.5, 0.5, 1}).Uniform(Color.DistanceCIEDE2000)
+  if c := flat.At(0.5); !almostEqualColor(c, Color{0.5, 0.5, 0.5, 1}, 1e-6) {
+    t.Errorf("flat gradient At(0.5) = %v", c)
+  }
+}
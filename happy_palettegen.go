@@ -20,25 +20,31 @@
 
 package colorful
 
-import (
-  "math/rand"
-)
-
 // Uses the HSV color space to generate colors with similar S,V but distributed
 // evenly along their Hue. This is fast but not always pretty.
 // If you've got time to spare, use Lab (the non-fast below).
-func FastHappyPalette(colorsCount int) (colors []Color) {
+func FastHappyPalette(colorsCount int) []Color {
+  return defaultGenerator.FastHappyPalette(colorsCount)
+}
+
+// FastHappyPalette with the Generator as source of randomness.
+func (g *Generator) FastHappyPalette(colorsCount int) (colors []Color) {
   colors = make([]Color, colorsCount)
 
   for i := 0; i < colorsCount; i++ {
-    colors[i] = ColorHsv{float64(i) * (360.0 / float64(colorsCount)), 0.8 + rand.Float64()*0.2, 0.65 + rand.Float64()*0.2}.Color()
+    colors[i] = ColorHsv{float64(i) * (360.0 / float64(colorsCount)), 0.8 + g.float64()*0.2, 0.65 + g.float64()*0.2}.Color()
   }
   return
 }
 
 func HappyPalette(colorsCount int) ([]Color, error) {
+  return defaultGenerator.HappyPalette(colorsCount)
+}
+
+// HappyPalette with the Generator as source of randomness.
+func (g *Generator) HappyPalette(colorsCount int) ([]Color, error) {
   pimpy := func(lab ColorLab) bool {
     return 0.3 <= lab.Hcl().C && 0.4 <= lab.L && lab.L <= 0.8
   }
-  return SoftPaletteEx(colorsCount, SoftPaletteSettings{pimpy, 50, true})
+  return g.SoftPaletteEx(colorsCount, SoftPaletteSettings{CheckColor: pimpy, Iterations: 50, ManySamples: true})
 }
@@ -0,0 +1,292 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+)
+
+// HCT is the color space of Material Design: the hue and chroma of CAM16 with
+// the tone L* of CIELAB. Converting to RGB gives the 8 bit sRGB color with the
+// hue and tone and as much of the chroma as fits, exactly like Material's
+// color utilities do, so the results are the same as on Android and the web.
+type ColorHct struct {
+  // Hue in [0..360], chroma from 0 to about 130 and tone in [0..100].
+  H, C, T float64
+}
+
+// Hct converts the color into HCT.
+func (c Color) Hct() ColorHct {
+  lin := c.LinearRgb()
+  x, y, z := mulMat3(cam16XyzFromLinRgb, lin.R*100.0, lin.G*100.0, lin.B*100.0)
+  cam := DefaultViewingConditions.cam16(x, y, z)
+  return ColorHct{cam.H, cam.C, lstarFromY(y)}
+}
+
+// Color gives the 8 bit sRGB color closest to the hct, keeping its hue and tone
+// and lowering its chroma if needed.
+func (hct ColorHct) Color() Color {
+  return hctSolve(hct.H, hct.C, hct.T)
+}
+
+// Hct creates a color from hue, chroma and tone, see ColorHct.Color.
+func Hct(h, c, t float64) Color {
+  return hctSolve(h, c, t)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+/// HCT solver, a port of the HctSolver of Material's color utilities
+///////////////////////////////////////////////////////////////////////////////
+
+var hctScaledDiscountFromLinRgb = [3][3]float64{
+  {0.001200833568784504, 0.002389694492170889, 0.0002795742885861124},
+  {0.0005891086651375999, 0.0029785502573438758, 0.0003270666104008398},
+  {0.00010146692491640572, 0.0005364214359186694, 0.0032979401770712076},
+}
+
+var hctLinRgbFromScaledDiscount = [3][3]float64{
+  {1373.2198709594231, -1100.4251190754821, -7.278681089101213},
+  {-271.815969077903, 559.6580465940733, -32.46047482791194},
+  {1.9622899599665666, -57.173814538844006, 308.7233197812385},
+}
+
+var hctYFromLinRgb = [3]float64{0.2126, 0.7152, 0.0722}
+
+// The linear RGB values in [0..100] halfway between 8 bit values.
+var hctCriticalPlanes = func() (planes [255]float64) {
+  for i := range planes {
+    planes[i] = linearize((float64(i)+0.5)/255.0) * 100.0
+  }
+  return planes
+}()
+
+// hctSolve finds the 8 bit sRGB color of the hue, chroma and tone.
+func hctSolve(hue, chroma, lstar float64) Color {
+  if chroma < 0.0001 || lstar < 0.0001 || lstar > 99.9999 {
+    v := delinearize(yFromLstar(lstar) / 100.0)
+    return hctQuantize(v, v, v)
+  }
+  hue = math.Mod(hue, 360.0)
+  if hue < 0.0 {
+    hue += 360.0
+  }
+  hueRadians := hue / 180.0 * math.Pi
+  y := yFromLstar(lstar)
+  if lin, ok := hctFindResultByJ(hueRadians, chroma, y); ok {
+    return hctFromLinRgb(lin)
+  }
+  return hctFromLinRgb(hctBisectToLimit(y, hueRadians))
+}
+
+// hctQuantize rounds sRGB in [0..1] to 8 bits.
+func hctQuantize(r, g, b float64) Color {
+  q := func(v float64) uint8 {
+    return uint8(math.Max(0.0, math.Min(255.0, math.Round(v*255.0))))
+  }
+  return RGB(q(r), q(g), q(b))
+}
+
+func hctFromLinRgb(lin [3]float64) Color {
+  return hctQuantize(delinearize(lin[0]/100.0), delinearize(lin[1]/100.0), delinearize(lin[2]/100.0))
+}
+
+// hctFindResultByJ solves for the color with Newton's method on J, which fails
+// if the color is out of gamut.
+func hctFindResultByJ(hueRadians, chroma, y float64) (lin [3]float64, ok bool) {
+  vc := DefaultViewingConditions
+  j := math.Sqrt(y) * 11.0
+  tInnerCoeff := 1.0 / math.Pow(1.64-math.Pow(0.29, vc.n), 0.73)
+  eHue := 0.25 * (math.Cos(hueRadians+2.0) + 3.8)
+  p1 := eHue * (50000.0 / 13.0) * vc.nc * vc.ncb
+  hSin, hCos := math.Sin(hueRadians), math.Cos(hueRadians)
+  for round := 0; round < 5; round++ {
+    jNormalized := j / 100.0
+    alpha := 0.0
+    if chroma != 0.0 && j != 0.0 {
+      alpha = chroma / math.Sqrt(jNormalized)
+    }
+    t := math.Pow(alpha*tInnerCoeff, 1.0/0.9)
+    ac := vc.aw * math.Pow(jNormalized, 1.0/vc.c/vc.z)
+    p2 := ac / vc.nbb
+    gamma := 23.0 * (p2 + 0.305) * t / (23.0*p1 + 11.0*t*hCos + 108.0*t*hSin)
+    a, b := gamma*hCos, gamma*hSin
+    rA := (460.0*p2 + 451.0*a + 288.0*b) / 1403.0
+    gA := (460.0*p2 - 891.0*a - 261.0*b) / 1403.0
+    bA := (460.0*p2 - 220.0*a - 6300.0*b) / 1403.0
+    lin[0], lin[1], lin[2] = mulMat3(hctLinRgbFromScaledDiscount,
+      hctInverseChromaticAdaptation(rA), hctInverseChromaticAdaptation(gA), hctInverseChromaticAdaptation(bA))
+    if lin[0] < 0.0 || lin[1] < 0.0 || lin[2] < 0.0 {
+      return lin, false
+    }
+    fnj := hctYFromLinRgb[0]*lin[0] + hctYFromLinRgb[1]*lin[1] + hctYFromLinRgb[2]*lin[2]
+    if fnj <= 0.0 {
+      return lin, false
+    }
+    if round == 4 || math.Abs(fnj-y) < 0.002 {
+      if lin[0] > 100.01 || lin[1] > 100.01 || lin[2] > 100.01 {
+        return lin, false
+      }
+      return lin, true
+    }
+    // Newton's method, with 2 * fn(j) / j as the approximation of fn'(j).
+    j = j - (fnj-y)*j/(2.0*fnj)
+  }
+  return lin, false
+}
+
+func hctChromaticAdaptation(v float64) float64 {
+  af := math.Pow(math.Abs(v), 0.42)
+  return sign(v) * 400.0 * af / (af + 27.13)
+}
+
+func hctInverseChromaticAdaptation(v float64) float64 {
+  abs := math.Abs(v)
+  base := math.Max(0.0, 27.13*abs/(400.0-abs))
+  return sign(v) * math.Pow(base, 1.0/0.42)
+}
+
+// hctHueOf is the CAM16 hue in radians of linear RGB in [0..100].
+func hctHueOf(lin [3]float64) float64 {
+  r, g, b := mulMat3(hctScaledDiscountFromLinRgb, lin[0], lin[1], lin[2])
+  rA, gA, bA := hctChromaticAdaptation(r), hctChromaticAdaptation(g), hctChromaticAdaptation(b)
+  a := (11.0*rA + -12.0*gA + bA) / 11.0
+  bb := (rA + gA - 2.0*bA) / 9.0
+  return math.Atan2(bb, a)
+}
+
+func hctSanitizeRadians(angle float64) float64 {
+  return math.Mod(angle+math.Pi*8.0, math.Pi*2.0)
+}
+
+func hctAreInCyclicOrder(a, b, c float64) bool {
+  return hctSanitizeRadians(b-a) < hctSanitizeRadians(c-a)
+}
+
+// hctSetCoordinate is the point on the segment with the axis at the coordinate.
+func hctSetCoordinate(source [3]float64, coordinate float64, target [3]float64, axis int) [3]float64 {
+  t := (coordinate - source[axis]) / (target[axis] - source[axis])
+  return [3]float64{
+    source[0] + (target[0]-source[0])*t,
+    source[1] + (target[1]-source[1])*t,
+    source[2] + (target[2]-source[2])*t,
+  }
+}
+
+func hctIsBounded(x float64) bool {
+  return 0.0 <= x && x <= 100.0
+}
+
+// hctNthVertex is the n-th of the 12 intersections of the plane of constant Y
+// with the edges of the RGB cube, or false if it isn't on the cube.
+func hctNthVertex(y float64, n int) ([3]float64, bool) {
+  kR, kG, kB := hctYFromLinRgb[0], hctYFromLinRgb[1], hctYFromLinRgb[2]
+  coordA := 100.0
+  if n%4 <= 1 {
+    coordA = 0.0
+  }
+  coordB := 100.0
+  if n%2 == 0 {
+    coordB = 0.0
+  }
+  switch {
+  case n < 4:
+    g, b := coordA, coordB
+    r := (y - g*kG - b*kB) / kR
+    return [3]float64{r, g, b}, hctIsBounded(r)
+  case n < 8:
+    b, r := coordA, coordB
+    g := (y - r*kR - b*kB) / kG
+    return [3]float64{r, g, b}, hctIsBounded(g)
+  }
+  r, g := coordA, coordB
+  b := (y - r*kR - g*kG) / kB
+  return [3]float64{r, g, b}, hctIsBounded(b)
+}
+
+// hctBisectToSegment finds the edge of the plane of constant Y in the RGB cube
+// on which the hue lies.
+func hctBisectToSegment(y, targetHue float64) (left, right [3]float64) {
+  left, right = [3]float64{-1.0, -1.0, -1.0}, [3]float64{-1.0, -1.0, -1.0}
+  leftHue, rightHue := 0.0, 0.0
+  initialized, uncut := false, true
+  for n := 0; n < 12; n++ {
+    mid, ok := hctNthVertex(y, n)
+    if !ok {
+      continue
+    }
+    midHue := hctHueOf(mid)
+    if !initialized {
+      left, right = mid, mid
+      leftHue, rightHue = midHue, midHue
+      initialized = true
+      continue
+    }
+    if uncut || hctAreInCyclicOrder(leftHue, midHue, rightHue) {
+      uncut = false
+      if hctAreInCyclicOrder(leftHue, targetHue, midHue) {
+        right, rightHue = mid, midHue
+      } else {
+        left, leftHue = mid, midHue
+      }
+    }
+  }
+  return left, right
+}
+
+// hctBisectToLimit finds the color of the hue on the surface of the RGB cube,
+// bisecting along the planes between 8 bit values.
+func hctBisectToLimit(y, targetHue float64) [3]float64 {
+  left, right := hctBisectToSegment(y, targetHue)
+  leftHue := hctHueOf(left)
+  for axis := 0; axis < 3; axis++ {
+    if left[axis] == right[axis] {
+      continue
+    }
+    var lPlane, rPlane int
+    if left[axis] < right[axis] {
+      lPlane = int(math.Floor(hctTrueDelinearized(left[axis]) - 0.5))
+      rPlane = int(math.Ceil(hctTrueDelinearized(right[axis]) - 0.5))
+    } else {
+      lPlane = int(math.Ceil(hctTrueDelinearized(left[axis]) - 0.5))
+      rPlane = int(math.Floor(hctTrueDelinearized(right[axis]) - 0.5))
+    }
+    for i := 0; i < 8; i++ {
+      if lPlane-rPlane <= 1 && rPlane-lPlane <= 1 {
+        break
+      }
+      mPlane := int(math.Floor(float64(lPlane+rPlane) / 2.0))
+      mid := hctSetCoordinate(left, hctCriticalPlanes[mPlane], right, axis)
+      midHue := hctHueOf(mid)
+      if hctAreInCyclicOrder(leftHue, targetHue, midHue) {
+        right, rPlane = mid, mPlane
+      } else {
+        left, leftHue, lPlane = mid, midHue, mPlane
+      }
+    }
+  }
+  return [3]float64{(left[0] + right[0]) / 2.0, (left[1] + right[1]) / 2.0, (left[2] + right[2]) / 2.0}
+}
+
+// hctTrueDelinearized is the unrounded 8 bit sRGB value of linear RGB in [0..100].
+func hctTrueDelinearized(v float64) float64 {
+  return delinearize(v/100.0) * 255.0
+}
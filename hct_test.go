@@ -0,0 +1,56 @@
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+// Reference values of Material's color utilities.
+
+func TestHct(t *testing.T) {
+  tests := []struct {
+    c    Color
+    want ColorHct
+  }{
+    {Color{1, 0, 0, 1}, ColorHct{27.408, 113.357, 53.233}},
+    {Color{0, 1, 0, 1}, ColorHct{142.139, 108.410, 87.737}},
+    {Color{0, 0, 1, 1}, ColorHct{282.788, 87.230, 32.302}},
+    {Color{1, 1, 1, 1}, ColorHct{209.492, 2.869, 100.0}},
+    {Color{0, 0, 0, 1}, ColorHct{0.0, 0.0, 0.0}},
+  }
+  for _, tt := range tests {
+    got := tt.c.Hct()
+    if math.Abs(got.H-tt.want.H) > 1e-3 || math.Abs(got.C-tt.want.C) > 1e-3 || math.Abs(got.T-tt.want.T) > 1e-3 {
+      t.Errorf("%v.Hct() = %v, want %v", tt.c, got, tt.want)
+    }
+  }
+}
+
+func TestHctRoundTrip(t *testing.T) {
+  for r := 0; r < 256; r += 15 {
+    for g := 0; g < 256; g += 15 {
+      for b := 0; b < 256; b += 15 {
+        c := RGB(uint8(r), uint8(g), uint8(b))
+        if got := c.Hct().Color(); got != c {
+          t.Errorf("%v doesn't round trip through HCT: %v", c, got)
+        }
+      }
+    }
+  }
+}
+
+func TestHctSolve(t *testing.T) {
+  // Out of gamut chroma is lowered, keeping hue and tone.
+  for _, hue := range []float64{15, 90, 200, 300} {
+    for _, tone := range []float64{20, 50, 80} {
+      c := Hct(hue, 200.0, tone)
+      hct := c.Hct()
+      if math.Abs(hct.T-tone) > 0.5 {
+        t.Errorf("Hct(%v, 200, %v) has tone %v", hue, tone, hct.T)
+      }
+      if math.Abs(math.Mod(hct.H-hue+540.0, 360.0)-180.0) > 2.0 {
+        t.Errorf("Hct(%v, 200, %v) has hue %v", hue, tone, hct.H)
+      }
+    }
+  }
+}
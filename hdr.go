@@ -0,0 +1,249 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+)
+
+// HDR colors are absolute XYZ, with Y the luminance in cd/m², which isn't
+// limited to [0..1] like Color. Both Jzazbz and ICtCp are made for them.
+
+// The luminance of SDR white in HDR in cd/m², as in ITU-R BT.2408.
+const SDRWhiteLuminance = 203.0
+
+// Absolute converts relative XYZ, with Y = 1 for white, into absolute XYZ with
+// Y in cd/m², for a white of the luminance.
+func (c ColorXyz) Absolute(whiteLuminance float64) ColorXyz {
+  return ColorXyz{c.X * whiteLuminance, c.Y * whiteLuminance, c.Z * whiteLuminance}
+}
+
+// Relative is the inverse of Absolute.
+func (c ColorXyz) Relative(whiteLuminance float64) ColorXyz {
+  return ColorXyz{c.X / whiteLuminance, c.Y / whiteLuminance, c.Z / whiteLuminance}
+}
+
+// The PQ transfer function of SMPTE ST 2084, from luminance in [0..1] of 10000
+// cd/m² to signal, and back.
+const (
+  pqC1 = 3424.0 / 4096.0
+  pqC2 = 2413.0 / 128.0
+  pqC3 = 2392.0 / 128.0
+  pqM1 = 2610.0 / 16384.0
+  pqM2 = 2523.0 / 32.0
+)
+
+func pq(y, m2 float64) float64 {
+  yn := math.Pow(math.Max(y, 0.0), pqM1)
+  return math.Pow((pqC1+pqC2*yn)/(1.0+pqC3*yn), m2)
+}
+
+func pqInv(v, m2 float64) float64 {
+  vp := math.Pow(math.Max(v, 0.0), 1.0/m2)
+  return math.Pow(math.Max(vp-pqC1, 0.0)/(pqC2-pqC3*vp), 1.0/pqM1)
+}
+
+// inv3 inverts a 3x3 matrix.
+func inv3(m [3][3]float64) (r [3][3]float64) {
+  det := m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+    m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+    m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+  for i := 0; i < 3; i++ {
+    for j := 0; j < 3; j++ {
+      // Transposed cofactor.
+      a, b := (j+1)%3, (j+2)%3
+      c, d := (i+1)%3, (i+2)%3
+      r[i][j] = (m[a][c]*m[b][d] - m[a][d]*m[b][c]) / det
+    }
+  }
+  return r
+}
+
+///////////////////////////////////////////////////////////////////////////////
+/// Jzazbz
+///////////////////////////////////////////////////////////////////////////////
+// M. Safdar et al., "Perceptually uniform color space for image signals
+// including high dynamic range and wide gamut", Optics Express 25, 2017.
+
+// ColorJzazbz has Jz in about [0..0.17] for luminances up to 1000 cd/m², and
+// az and bz in about [-0.1..0.1].
+type ColorJzazbz struct {
+  J, A, B float64
+}
+
+const (
+  jzB  = 1.15
+  jzG  = 0.66
+  jzD  = -0.56
+  jzD0 = 1.6295499532821566e-11
+  jzP  = 1.7 * 2523.0 / 32.0
+)
+
+var (
+  jzLmsFromXyz = [3][3]float64{
+    {0.41478972, 0.579999, 0.0146480},
+    {-0.2015100, 1.120649, 0.0531008},
+    {-0.0166008, 0.264800, 0.6684799},
+  }
+  jzXyzFromLms = inv3(jzLmsFromXyz)
+  jzIabFromLms = [3][3]float64{
+    {0.5, 0.5, 0.0},
+    {3.524000, -4.066708, 0.542708},
+    {0.199076, 1.096799, -1.295875},
+  }
+  jzLmsFromIab = inv3(jzIabFromLms)
+)
+
+// Jzazbz converts absolute XYZ, with Y in cd/m², into Jzazbz.
+func (c ColorXyz) Jzazbz() ColorJzazbz {
+  x := jzB*c.X - (jzB-1.0)*c.Z
+  y := jzG*c.Y - (jzG-1.0)*c.X
+  l, m, s := mulMat3(jzLmsFromXyz, x, y, c.Z)
+  i, a, b := mulMat3(jzIabFromLms, pq(l/10000.0, jzP), pq(m/10000.0, jzP), pq(s/10000.0, jzP))
+  return ColorJzazbz{(1.0+jzD)*i/(1.0+jzD*i) - jzD0, a, b}
+}
+
+// Xyz converts into absolute XYZ, with Y in cd/m².
+func (c ColorJzazbz) Xyz() ColorXyz {
+  j := c.J + jzD0
+  i := j / (1.0 + jzD - jzD*j)
+  l, m, s := mulMat3(jzLmsFromIab, i, c.A, c.B)
+  x, y, z := mulMat3(jzXyzFromLms, pqInv(l, jzP)*10000.0, pqInv(m, jzP)*10000.0, pqInv(s, jzP)*10000.0)
+  x = (x + (jzB-1.0)*z) / jzB
+  y = (y + (jzG-1.0)*x) / jzG
+  return ColorXyz{x, y, z}
+}
+
+// Jzazbz converts the color into Jzazbz, with white at SDRWhiteLuminance.
+func (c Color) Jzazbz() ColorJzazbz {
+  return c.Xyz().Absolute(SDRWhiteLuminance).Jzazbz()
+}
+
+// Color converts from Jzazbz, with white at SDRWhiteLuminance. HDR colors
+// are out of the range of Color.
+func (c ColorJzazbz) Color() Color {
+  return c.Xyz().Relative(SDRWhiteLuminance).Color()
+}
+
+// ColorJzCzhz is the cylindrical form of Jzazbz, with hue in [0..360].
+type ColorJzCzhz struct {
+  J, C, H float64
+}
+
+func (c ColorJzazbz) JzCzhz() ColorJzCzhz {
+  h := math.Mod(math.Atan2(c.B, c.A)*180.0/math.Pi+360.0, 360.0)
+  return ColorJzCzhz{c.J, math.Hypot(c.A, c.B), h}
+}
+
+func (c ColorJzCzhz) Jzazbz() ColorJzazbz {
+  h := c.H * math.Pi / 180.0
+  return ColorJzazbz{c.J, c.C * math.Cos(h), c.C * math.Sin(h)}
+}
+
+// DistEz is the color difference ΔEz of Jzazbz.
+func (c1 ColorJzazbz) DistEz(c2 ColorJzazbz) float64 {
+  lch1, lch2 := c1.JzCzhz(), c2.JzCzhz()
+  dh := (lch1.H - lch2.H) * math.Pi / 180.0
+  dH := 2.0 * math.Sqrt(lch1.C*lch2.C) * math.Sin(dh/2.0)
+  return math.Sqrt(sq(lch1.J-lch2.J) + sq(lch1.C-lch2.C) + sq(dH))
+}
+
+// DistanceEz is the color difference ΔEz of the colors, with white at
+// SDRWhiteLuminance.
+func (c1 Color) DistanceEz(c2 Color) float64 {
+  return c1.Jzazbz().DistEz(c2.Jzazbz())
+}
+
+///////////////////////////////////////////////////////////////////////////////
+/// ICtCp
+///////////////////////////////////////////////////////////////////////////////
+// ITU-R BT.2100, with the PQ transfer function.
+
+// ColorICtCp has I in [0..1] for luminances up to 10000 cd/m², Ct and Cp in
+// about [-0.5..0.5].
+type ColorICtCp struct {
+  I, Ct, Cp float64
+}
+
+var (
+  rec2020FromXyz = [3][3]float64{
+    {1.7166511880, -0.3556707838, -0.2533662814},
+    {-0.6666843518, 1.6164812366, 0.0157685458},
+    {0.0176398574, -0.0427706133, 0.9421031212},
+  }
+  rec2020ToXyz = [3][3]float64{
+    {0.6369580483, 0.1446169036, 0.1688809752},
+    {0.2627002120, 0.6779980715, 0.0593017165},
+    {0.0000000000, 0.0280726930, 1.0609850577},
+  }
+  ictcpLmsFromRec2020 = [3][3]float64{
+    {1688.0 / 4096.0, 2146.0 / 4096.0, 262.0 / 4096.0},
+    {683.0 / 4096.0, 2951.0 / 4096.0, 462.0 / 4096.0},
+    {99.0 / 4096.0, 309.0 / 4096.0, 3688.0 / 4096.0},
+  }
+  ictcpRec2020FromLms = inv3(ictcpLmsFromRec2020)
+  ictcpFromLms        = [3][3]float64{
+    {0.5, 0.5, 0.0},
+    {6610.0 / 4096.0, -13613.0 / 4096.0, 7003.0 / 4096.0},
+    {17933.0 / 4096.0, -17390.0 / 4096.0, -543.0 / 4096.0},
+  }
+  ictcpToLms = inv3(ictcpFromLms)
+)
+
+// ICtCp converts absolute XYZ, with Y in cd/m², into ICtCp.
+func (c ColorXyz) ICtCp() ColorICtCp {
+  r, g, b := mulMat3(rec2020FromXyz, c.X, c.Y, c.Z)
+  l, m, s := mulMat3(ictcpLmsFromRec2020, r, g, b)
+  i, ct, cp := mulMat3(ictcpFromLms, pq(l/10000.0, pqM2), pq(m/10000.0, pqM2), pq(s/10000.0, pqM2))
+  return ColorICtCp{i, ct, cp}
+}
+
+// Xyz converts into absolute XYZ, with Y in cd/m².
+func (c ColorICtCp) Xyz() ColorXyz {
+  l, m, s := mulMat3(ictcpToLms, c.I, c.Ct, c.Cp)
+  r, g, b := mulMat3(ictcpRec2020FromLms, pqInv(l, pqM2)*10000.0, pqInv(m, pqM2)*10000.0, pqInv(s, pqM2)*10000.0)
+  x, y, z := mulMat3(rec2020ToXyz, r, g, b)
+  return ColorXyz{x, y, z}
+}
+
+// ICtCp converts the color into ICtCp, with white at SDRWhiteLuminance.
+func (c Color) ICtCp() ColorICtCp {
+  return c.Xyz().Absolute(SDRWhiteLuminance).ICtCp()
+}
+
+// Color converts from ICtCp, with white at SDRWhiteLuminance. HDR colors
+// are out of the range of Color.
+func (c ColorICtCp) Color() Color {
+  return c.Xyz().Relative(SDRWhiteLuminance).Color()
+}
+
+// DistITP is the color difference ΔE_ITP of ITU-R BT.2124, in which 1 is
+// about the smallest noticeable difference.
+func (c1 ColorICtCp) DistITP(c2 ColorICtCp) float64 {
+  return 720.0 * math.Sqrt(sq(c1.I-c2.I)+sq(0.5*(c1.Ct-c2.Ct))+sq(c1.Cp-c2.Cp))
+}
+
+// DistanceITP is the color difference ΔE_ITP of the colors, with white at
+// SDRWhiteLuminance.
+func (c1 Color) DistanceITP(c2 Color) float64 {
+  return c1.ICtCp().DistITP(c2.ICtCp())
+}
@@ -0,0 +1,79 @@
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+func TestJzazbz(t *testing.T) {
+  // Reference value of colour-science, with XYZ in cd/m².
+  got := ColorXyz{0.20654008, 0.12197225, 0.05136952}.Jzazbz()
+  want := ColorJzazbz{0.00535048, 0.00924302, 0.00526007}
+  if math.Abs(got.J-want.J) > 1e-7 || math.Abs(got.A-want.A) > 1e-7 || math.Abs(got.B-want.B) > 1e-7 {
+    t.Errorf("Jzazbz() = %v, want %v", got, want)
+  }
+
+  // HDR colors round trip too.
+  for _, xyz := range []ColorXyz{{95.047, 100, 108.883}, {2000, 1500, 300}, {0.5, 0.2, 3}, {0, 0, 0}} {
+    back := xyz.Jzazbz().Xyz()
+    if math.Abs(back.X-xyz.X) > 1e-6*(1+xyz.X) || math.Abs(back.Y-xyz.Y) > 1e-6*(1+xyz.Y) || math.Abs(back.Z-xyz.Z) > 1e-6*(1+xyz.Z) {
+      t.Errorf("%v round trips to %v", xyz, back)
+    }
+  }
+
+  // Up to the precision of the XYZ matrices.
+  for _, c := range []Color{{0.2, 0.4, 0.6, 1}, {1, 1, 1, 1}, {0.9, 0.1, 0.1, 1}} {
+    if back := c.Jzazbz().JzCzhz().Jzazbz().Color(); !almostEqualColor(back, c, 1e-6) {
+      t.Errorf("%v round trips to %v", c, back)
+    }
+  }
+
+  // Brighter is lighter.
+  if j1, j2 := (ColorXyz{95.047, 100, 108.883}).Jzazbz().J, (ColorXyz{950.47, 1000, 1088.83}).Jzazbz().J; j2 <= j1 {
+    t.Errorf("Jz of 1000 cd/m² white %v isn't above the one of 100 cd/m² %v", j2, j1)
+  }
+}
+
+func TestICtCp(t *testing.T) {
+  // White is neutral, up to D65 being rounded, and PQ gives 0.5081 at
+  // 100 cd/m² and 1 at 10000.
+  for _, tt := range []struct{ luminance, i float64 }{{100, 0.508078}, {10000, 1.0}} {
+    got := ColorXyz{D65[0], D65[1], D65[2]}.Absolute(tt.luminance).ICtCp()
+    if math.Abs(got.I-tt.i) > 1e-6 || math.Abs(got.Ct) > 1e-4 || math.Abs(got.Cp) > 1e-4 {
+      t.Errorf("white at %v cd/m² is %v, want I %v", tt.luminance, got, tt.i)
+    }
+  }
+
+  for _, xyz := range []ColorXyz{{95.047, 100, 108.883}, {2000, 1500, 300}, {0.5, 0.2, 3}} {
+    back := xyz.ICtCp().Xyz()
+    if math.Abs(back.X-xyz.X) > 1e-6*(1+xyz.X) || math.Abs(back.Y-xyz.Y) > 1e-6*(1+xyz.Y) || math.Abs(back.Z-xyz.Z) > 1e-6*(1+xyz.Z) {
+      t.Errorf("%v round trips to %v", xyz, back)
+    }
+  }
+
+  for _, c := range []Color{{0.2, 0.4, 0.6, 1}, {1, 1, 1, 1}, {0.9, 0.1, 0.1, 1}} {
+    if back := c.ICtCp().Color(); !almostEqualColor(back, c, 1e-6) {
+      t.Errorf("%v round trips to %v", c, back)
+    }
+  }
+}
+
+func TestHDRDistances(t *testing.T) {
+  c1, c2, c3 := Color{0.5, 0.5, 0.5, 1}, Color{0.5, 0.5, 0.51, 1}, Color{0.5, 0.2, 0.2, 1}
+  for name, dist := range map[string]func(a, b Color) float64{"Ez": Color.DistanceEz, "ITP": Color.DistanceITP} {
+    if d := dist(c1, c1); d != 0.0 {
+      t.Errorf("%v: distance to itself is %v", name, d)
+    }
+    if d1, d2 := dist(c1, c3), dist(c3, c1); math.Abs(d1-d2) > 1e-12 {
+      t.Errorf("%v: distance isn't symmetric: %v and %v", name, d1, d2)
+    }
+    if d1, d2 := dist(c1, c2), dist(c1, c3); d1 >= d2 {
+      t.Errorf("%v: close colors are %v apart, far ones %v", name, d1, d2)
+    }
+  }
+
+  // A step of one in 255 is about noticeable.
+  if d := c1.DistanceITP(c2); d < 0.3 || d > 3.0 {
+    t.Errorf("ΔE_ITP of one step is %v", d)
+  }
+}
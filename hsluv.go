@@ -0,0 +1,197 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+)
+
+///////////////////////////////////////////////////////////////////////////////
+/// LCh(uv)
+///////////////////////////////////////////////////////////////////////////////
+
+// ColorLchUv is the cylindrical form of L*u*v*, with hue in [0..360].
+type ColorLchUv struct {
+  L, C, H float64
+}
+
+func (c ColorLuv) LchUv() ColorLchUv {
+  h := 0.0
+  // Grays don't have a hue.
+  if math.Abs(c.U) > 1e-9 || math.Abs(c.V) > 1e-9 {
+    h = math.Mod(math.Atan2(c.V, c.U)*180.0/math.Pi+360.0, 360.0)
+  }
+  return ColorLchUv{c.L, math.Hypot(c.U, c.V), h}
+}
+
+func (c ColorLchUv) Luv() ColorLuv {
+  h := c.H * math.Pi / 180.0
+  return ColorLuv{c.L, c.C * math.Cos(h), c.C * math.Sin(h)}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+/// HSLuv and HPLuv
+///////////////////////////////////////////////////////////////////////////////
+// https://www.hsluv.org/math/
+
+// ColorHsluv is HSL made from LCh(uv): its lightness is L* and its saturation
+// the fraction of the most chroma sRGB has at that hue and lightness.
+// H in [0..360], S and L in [0..1].
+type ColorHsluv struct {
+  H, S, L float64
+}
+
+// ColorHpluv is like ColorHsluv, but its saturation is the fraction of the most
+// chroma sRGB has at that lightness for all hues. So it keeps chroma when
+// changing hue, but is limited to pastel colors.
+// H in [0..360], S and L in [0..1].
+type ColorHpluv struct {
+  H, S, L float64
+}
+
+func (c Color) Hsluv() ColorHsluv {
+  lch := c.Luv().LchUv()
+  if lch.L > 1.0-1e-9 || lch.L < 1e-9 {
+    return ColorHsluv{lch.H, 0.0, clamp01(lch.L)}
+  }
+  return ColorHsluv{lch.H, lch.C / luvMaxChroma(lch.L, lch.H), lch.L}
+}
+
+func (c ColorHsluv) Color() Color {
+  return c.LchUv().Luv().Color()
+}
+
+func (c ColorHsluv) LchUv() ColorLchUv {
+  if c.L > 1.0-1e-9 || c.L < 1e-9 {
+    return ColorLchUv{clamp01(c.L), 0.0, c.H}
+  }
+  return ColorLchUv{c.L, c.S * luvMaxChroma(c.L, c.H), c.H}
+}
+
+func (c Color) Hpluv() ColorHpluv {
+  lch := c.Luv().LchUv()
+  if lch.L > 1.0-1e-9 || lch.L < 1e-9 {
+    return ColorHpluv{lch.H, 0.0, clamp01(lch.L)}
+  }
+  return ColorHpluv{lch.H, lch.C / luvMaxSafeChroma(lch.L), lch.L}
+}
+
+func (c ColorHpluv) Color() Color {
+  return c.LchUv().Luv().Color()
+}
+
+func (c ColorHpluv) LchUv() ColorLchUv {
+  if c.L > 1.0-1e-9 || c.L < 1e-9 {
+    return ColorLchUv{clamp01(c.L), 0.0, c.H}
+  }
+  return ColorLchUv{c.L, c.S * luvMaxSafeChroma(c.L), c.H}
+}
+
+// BlendHsluv blends two colors in HSLuv, along the shorter way around the hues.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendHsluv(c2 Color, t float64) Color {
+  h1, h2 := c1.Hsluv(), c2.Hsluv()
+  h1.H, h2.H = achromaticHues(c1, h1.H, c2, h2.H)
+  return ColorHsluv{interpHue(h1.H, h2.H, t), h1.S + t*(h2.S-h1.S), h1.L + t*(h2.L-h1.L)}.Color()
+}
+
+// BlendHpluv blends two colors in HPLuv, along the shorter way around the hues.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendHpluv(c2 Color, t float64) Color {
+  h1, h2 := c1.Hpluv(), c2.Hpluv()
+  h1.H, h2.H = achromaticHues(c1, h1.H, c2, h2.H)
+  return ColorHpluv{interpHue(h1.H, h2.H, t), h1.S + t*(h2.S-h1.S), h1.L + t*(h2.L-h1.L)}.Color()
+}
+
+// achromaticHues gives grays the hue of the other color, so blends don't pass
+// through unrelated hues. Like the reference implementation, it looks at the
+// chroma rather than the saturation, which grows without bounds near black.
+func achromaticHues(c1 Color, h1 float64, c2 Color, h2 float64) (float64, float64) {
+  if c1.Luv().LchUv().C < hsluvAchromatic {
+    return h2, h2
+  } else if c2.Luv().LchUv().C < hsluvAchromatic {
+    return h1, h1
+  }
+  return h1, h2
+}
+
+// hsluvAchromatic is the LCh(uv) chroma below which a color counts as gray.
+// The reference uses 1e-8 on its 0..100 scale, but the grays of this package
+// come out with a chroma of up to 2e-6, as the sRGB matrix and the D65 white
+// don't quite match.
+const hsluvAchromatic = 1e-5
+
+// luvLine is a line v = slope*u + intercept in the u*v* plane.
+type luvLine struct {
+  slope, intercept float64
+}
+
+// luvBounds are the six lines where one of the linear RGB channels is 0 or 1
+// at the lightness, which bound sRGB in the u*v* plane.
+func luvBounds(l float64) (lines [6]luvLine) {
+  var y float64
+  if l <= 0.08 {
+    y = D65[1] * l * 100.0 * 3.0 / 29.0 * 3.0 / 29.0 * 3.0 / 29.0
+  } else {
+    y = D65[1] * cub((l+0.16)/1.16)
+  }
+  un, vn := xyz_to_uv(D65[0], D65[1], D65[2])
+
+  // The rows of ColorXyz.LinearRgb.
+  m := [3][3]float64{
+    {3.2404542, -1.5371385, -0.4985314},
+    {-0.9692660, 1.8760108, 0.0415560},
+    {0.0556434, -0.2040259, 1.0572252},
+  }
+  for c := 0; c < 3; c++ {
+    for t := 0; t < 2; t++ {
+      // Channel c is t where a*u' + b*v' + k = 0, with u' = u/(13L) + un.
+      a := y * (9.0*m[c][0] - 3.0*m[c][2])
+      b := y*(4.0*m[c][1]-20.0*m[c][2]) - 4.0*float64(t)
+      k := 12.0 * m[c][2] * y
+      lines[2*c+t] = luvLine{-a / b, -13.0 * l * (a*un + b*vn + k) / b}
+    }
+  }
+  return lines
+}
+
+// luvMaxChroma is the most chroma sRGB has at the lightness and hue.
+func luvMaxChroma(l, h float64) float64 {
+  hrad := h * math.Pi / 180.0
+  sin, cos := math.Sin(hrad), math.Cos(hrad)
+  min := math.Inf(+1)
+  for _, line := range luvBounds(l) {
+    if length := line.intercept / (sin - line.slope*cos); length >= 0.0 {
+      min = math.Min(min, length)
+    }
+  }
+  return min
+}
+
+// luvMaxSafeChroma is the most chroma sRGB has at the lightness for all hues.
+func luvMaxSafeChroma(l float64) float64 {
+  min := math.Inf(+1)
+  for _, line := range luvBounds(l) {
+    min = math.Min(min, math.Abs(line.intercept)/math.Sqrt(sq(line.slope)+1.0))
+  }
+  return min
+}
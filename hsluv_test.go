@@ -0,0 +1,94 @@
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+func TestHsluvReference(t *testing.T) {
+  // Values from the reference implementation at https://www.hsluv.org/
+  cases := []struct {
+    c       Color
+    h, s, l float64
+  }{
+    {Color{1, 0, 0, 1}, 12.177, 1.0, 0.53237},
+    {Color{0, 1, 0, 1}, 127.715, 1.0, 0.87737},
+    {Color{0, 0, 1, 1}, 265.874, 1.0, 0.32301},
+  }
+  for _, tc := range cases {
+    hsl := tc.c.Hsluv()
+    if math.Abs(hsl.H-tc.h) > 0.01 || math.Abs(hsl.S-tc.s) > 1e-4 || math.Abs(hsl.L-tc.l) > 1e-4 {
+      t.Errorf("%v.Hsluv() = %v, want {%v %v %v}", tc.c.HexString(), hsl, tc.h, tc.s, tc.l)
+    }
+  }
+}
+
+func TestHsluvGamutBoundary(t *testing.T) {
+  const eps = 1e-6
+  inGamut := func(c Color) bool {
+    lo := math.Min(c.R, math.Min(c.G, c.B))
+    hi := math.Max(c.R, math.Max(c.G, c.B))
+    return lo > -eps && hi < 1.0+eps
+  }
+  onBoundary := func(c Color) bool {
+    lo := math.Min(c.R, math.Min(c.G, c.B))
+    hi := math.Max(c.R, math.Max(c.G, c.B))
+    return inGamut(c) && (math.Abs(lo) < eps || math.Abs(hi-1.0) < eps)
+  }
+  for h := 0.0; h < 360.0; h += 15.0 {
+    for l := 0.05; l < 1.0; l += 0.1 {
+      if c := (ColorHsluv{h, 1.0, l}).Color(); !onBoundary(c) {
+        t.Errorf("HSLuv{%v 1 %v} = %v, should be on the sRGB boundary", h, l, c)
+      }
+      if c := (ColorHpluv{h, 1.0, l}).Color(); !inGamut(c) {
+        t.Errorf("HPLuv{%v 1 %v} = %v, should be in sRGB", h, l, c)
+      }
+    }
+  }
+}
+
+func TestHsluvRoundTrip(t *testing.T) {
+  for _, c := range []Color{{0.2, 0.4, 0.6, 1}, {0.9, 0.1, 0.5, 1}, {0.5, 0.5, 0.5, 1}, {0.05, 0.8, 0.3, 1}} {
+    if c2 := c.Hsluv().Color(); !almostEqualColor(c, c2, 1e-6) {
+      t.Errorf("HSLuv round trip of %v gives %v", c, c2)
+    }
+    if c2 := c.Hpluv().Color(); !almostEqualColor(c, c2, 1e-6) {
+      t.Errorf("HPLuv round trip of %v gives %v", c, c2)
+    }
+  }
+}
+
+func TestBlendHsluv(t *testing.T) {
+  c1, c2 := Color{1, 0, 0, 1}, Color{0, 0, 1, 1}
+  if c := c1.BlendHsluv(c2, 0); !almostEqualColor(c, c1, 1e-5) {
+    t.Errorf("BlendHsluv at 0 = %v, want %v", c, c1)
+  }
+  if c := c1.BlendHsluv(c2, 1); !almostEqualColor(c, c2, 1e-5) {
+    t.Errorf("BlendHsluv at 1 = %v, want %v", c, c2)
+  }
+  // Red and blue are both fully saturated, so the whole way is.
+  if s := c1.BlendHsluv(c2, 0.5).Hsluv().S; math.Abs(s-1.0) > 1e-4 {
+    t.Errorf("BlendHsluv midpoint saturation = %v, want 1", s)
+  }
+  // A gray takes the hue of the other color.
+  gray := Color{0.5, 0.5, 0.5, 1}
+  if h := gray.BlendHpluv(c1, 0.5).Hpluv().H; math.Abs(h-c1.Hpluv().H) > 0.01 {
+    t.Errorf("BlendHpluv from gray changed hue to %v", h)
+  }
+  // A faint blue is no gray, even though its hue is only barely defined.
+  faint := Color{0.5, 0.5, 0.502, 1}
+  if h := faint.BlendHsluv(c1, 0.5).Hsluv().H; math.Abs(h-c1.Hsluv().H) < 1.0 {
+    t.Errorf("BlendHsluv from a faint blue kept the hue of red, %v", h)
+  }
+}
+
+func TestHsluvPaletteToLightness(t *testing.T) {
+  pal := ColorHsluv{120, 0.8, 0.4}.PaletteToMaxLightness(5)
+  if len(pal) != 5 || math.Abs(pal[4].L-1.0) > 1e-9 || math.Abs(pal[0].L-0.52) > 1e-9 {
+    t.Errorf("PaletteToMaxLightness = %v", pal)
+  }
+  hpal := ColorHpluv{120, 0.8, 0.3}.PaletteToLightnessFor(0.1, 0.9, 4)
+  if len(hpal) != 4 || math.Abs(hpal[3].L-0.9) > 1e-9 {
+    t.Errorf("PaletteToLightnessFor = %v", hpal)
+  }
+}
@@ -0,0 +1,193 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+// Material Design color schemes, as Material's color utilities make them.
+// https://m3.material.io/styles/color/system/how-the-system-works
+
+// TonalPalette is a hue and chroma in HCT, which give a color for every tone.
+type TonalPalette struct {
+  Hue    float64
+  Chroma float64
+}
+
+// NewTonalPalette makes the tonal palette of the hue and chroma of the color.
+func NewTonalPalette(c Color) TonalPalette {
+  hct := c.Hct()
+  return TonalPalette{hct.H, hct.C}
+}
+
+// Tone gives the color of the tone in [0..100], 0 is black and 100 white.
+func (p TonalPalette) Tone(tone float64) Color {
+  return Hct(p.Hue, p.Chroma, tone)
+}
+
+// MaterialPalettes are the tonal palettes the roles of a scheme take their
+// colors from.
+type MaterialPalettes struct {
+  Primary        TonalPalette
+  Secondary      TonalPalette
+  Tertiary       TonalPalette
+  Neutral        TonalPalette
+  NeutralVariant TonalPalette
+  Error          TonalPalette
+}
+
+// NewMaterialPalettes derives the palettes from a seed color, keeping its hue.
+func NewMaterialPalettes(seed Color) MaterialPalettes {
+  hct := seed.Hct()
+  chroma := hct.C
+  if chroma < 48.0 {
+    chroma = 48.0
+  }
+  return MaterialPalettes{
+    Primary:        TonalPalette{hct.H, chroma},
+    Secondary:      TonalPalette{hct.H, 16.0},
+    Tertiary:       TonalPalette{hct.H + 60.0, 24.0},
+    Neutral:        TonalPalette{hct.H, 4.0},
+    NeutralVariant: TonalPalette{hct.H, 8.0},
+    Error:          TonalPalette{25.0, 84.0},
+  }
+}
+
+// MaterialScheme are the colors of the roles of a theme.
+type MaterialScheme struct {
+  Primary            Color
+  OnPrimary          Color
+  PrimaryContainer   Color
+  OnPrimaryContainer Color
+
+  Secondary            Color
+  OnSecondary          Color
+  SecondaryContainer   Color
+  OnSecondaryContainer Color
+
+  Tertiary            Color
+  OnTertiary          Color
+  TertiaryContainer   Color
+  OnTertiaryContainer Color
+
+  Error            Color
+  OnError          Color
+  ErrorContainer   Color
+  OnErrorContainer Color
+
+  Background       Color
+  OnBackground     Color
+  Surface          Color
+  OnSurface        Color
+  SurfaceVariant   Color
+  OnSurfaceVariant Color
+  Outline          Color
+  OutlineVariant   Color
+  Shadow           Color
+  Scrim            Color
+  InverseSurface   Color
+  InverseOnSurface Color
+  InversePrimary   Color
+}
+
+// MaterialLightScheme makes the scheme of a light theme from a seed color.
+func MaterialLightScheme(seed Color) MaterialScheme {
+  return NewMaterialPalettes(seed).LightScheme()
+}
+
+// MaterialDarkScheme makes the scheme of a dark theme from a seed color.
+func MaterialDarkScheme(seed Color) MaterialScheme {
+  return NewMaterialPalettes(seed).DarkScheme()
+}
+
+func (p MaterialPalettes) LightScheme() MaterialScheme {
+  return MaterialScheme{
+    Primary:            p.Primary.Tone(40),
+    OnPrimary:          p.Primary.Tone(100),
+    PrimaryContainer:   p.Primary.Tone(90),
+    OnPrimaryContainer: p.Primary.Tone(10),
+
+    Secondary:            p.Secondary.Tone(40),
+    OnSecondary:          p.Secondary.Tone(100),
+    SecondaryContainer:   p.Secondary.Tone(90),
+    OnSecondaryContainer: p.Secondary.Tone(10),
+
+    Tertiary:            p.Tertiary.Tone(40),
+    OnTertiary:          p.Tertiary.Tone(100),
+    TertiaryContainer:   p.Tertiary.Tone(90),
+    OnTertiaryContainer: p.Tertiary.Tone(10),
+
+    Error:            p.Error.Tone(40),
+    OnError:          p.Error.Tone(100),
+    ErrorContainer:   p.Error.Tone(90),
+    OnErrorContainer: p.Error.Tone(10),
+
+    Background:       p.Neutral.Tone(99),
+    OnBackground:     p.Neutral.Tone(10),
+    Surface:          p.Neutral.Tone(99),
+    OnSurface:        p.Neutral.Tone(10),
+    SurfaceVariant:   p.NeutralVariant.Tone(90),
+    OnSurfaceVariant: p.NeutralVariant.Tone(30),
+    Outline:          p.NeutralVariant.Tone(50),
+    OutlineVariant:   p.NeutralVariant.Tone(80),
+    Shadow:           p.Neutral.Tone(0),
+    Scrim:            p.Neutral.Tone(0),
+    InverseSurface:   p.Neutral.Tone(20),
+    InverseOnSurface: p.Neutral.Tone(95),
+    InversePrimary:   p.Primary.Tone(80),
+  }
+}
+
+func (p MaterialPalettes) DarkScheme() MaterialScheme {
+  return MaterialScheme{
+    Primary:            p.Primary.Tone(80),
+    OnPrimary:          p.Primary.Tone(20),
+    PrimaryContainer:   p.Primary.Tone(30),
+    OnPrimaryContainer: p.Primary.Tone(90),
+
+    Secondary:            p.Secondary.Tone(80),
+    OnSecondary:          p.Secondary.Tone(20),
+    SecondaryContainer:   p.Secondary.Tone(30),
+    OnSecondaryContainer: p.Secondary.Tone(90),
+
+    Tertiary:            p.Tertiary.Tone(80),
+    OnTertiary:          p.Tertiary.Tone(20),
+    TertiaryContainer:   p.Tertiary.Tone(30),
+    OnTertiaryContainer: p.Tertiary.Tone(90),
+
+    Error:            p.Error.Tone(80),
+    OnError:          p.Error.Tone(20),
+    ErrorContainer:   p.Error.Tone(30),
+    OnErrorContainer: p.Error.Tone(90),
+
+    Background:       p.Neutral.Tone(10),
+    OnBackground:     p.Neutral.Tone(90),
+    Surface:          p.Neutral.Tone(10),
+    OnSurface:        p.Neutral.Tone(90),
+    SurfaceVariant:   p.NeutralVariant.Tone(30),
+    OnSurfaceVariant: p.NeutralVariant.Tone(80),
+    Outline:          p.NeutralVariant.Tone(60),
+    OutlineVariant:   p.NeutralVariant.Tone(30),
+    Shadow:           p.Neutral.Tone(0),
+    Scrim:            p.Neutral.Tone(0),
+    InverseSurface:   p.Neutral.Tone(90),
+    InverseOnSurface: p.Neutral.Tone(20),
+    InversePrimary:   p.Primary.Tone(40),
+  }
+}
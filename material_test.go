@@ -0,0 +1,130 @@
+package colorful
+
+import (
+  "testing"
+)
+
+// Reference values of Material's color utilities.
+
+func TestTonalPalette(t *testing.T) {
+  blue := NewTonalPalette(Color{0, 0, 1, 1})
+  tones := []struct {
+    tone float64
+    want string
+  }{
+    {100, "#ffffff"},
+    {95, "#f1efff"},
+    {90, "#e0e0ff"},
+    {80, "#bec2ff"},
+    {70, "#9da3ff"},
+    {60, "#7c84ff"},
+    {50, "#5a64ff"},
+    {40, "#343dff"},
+    {30, "#0000ef"},
+    {20, "#0001ac"},
+    {10, "#00006e"},
+    {0, "#000000"},
+  }
+  for _, tt := range tones {
+    if got := blue.Tone(tt.tone).HexString(); got != tt.want {
+      t.Errorf("Tone(%v) = %v, want %v", tt.tone, got, tt.want)
+    }
+  }
+}
+
+func TestMaterialScheme(t *testing.T) {
+  blue := Color{0, 0, 1, 1}
+  light, dark := MaterialLightScheme(blue), MaterialDarkScheme(blue)
+  tests := []struct {
+    role string
+    got  Color
+    want string
+  }{
+    {"light primary", light.Primary, "#343dff"},
+    {"light on primary", light.OnPrimary, "#ffffff"},
+    {"light primary container", light.PrimaryContainer, "#e0e0ff"},
+    {"light inverse primary", light.InversePrimary, "#bec2ff"},
+    {"dark primary", dark.Primary, "#bec2ff"},
+    {"dark on primary", dark.OnPrimary, "#0001ac"},
+    {"dark primary container", dark.PrimaryContainer, "#0000ef"},
+    {"dark inverse primary", dark.InversePrimary, "#343dff"},
+  }
+  for _, tt := range tests {
+    if got := tt.got.HexString(); got != tt.want {
+      t.Errorf("%v = %v, want %v", tt.role, got, tt.want)
+    }
+  }
+
+  // Roles on each other are the same palette at distant tones, and so readable.
+  for _, s := range []MaterialScheme{light, dark} {
+    pairs := [][2]Color{
+      {s.OnPrimary, s.Primary},
+      {s.OnSecondaryContainer, s.SecondaryContainer},
+      {s.OnTertiary, s.Tertiary},
+      {s.OnError, s.Error},
+      {s.OnSurface, s.Surface},
+      {s.InverseOnSurface, s.InverseSurface},
+    }
+    for i, p := range pairs {
+      if r := p[0].ContrastRatio(p[1]); r < WCAGRatioAA {
+        t.Errorf("pair %v has contrast %v", i, r)
+      }
+    }
+  }
+
+  // Chroma is at least 48 for the primary palette.
+  if p := NewMaterialPalettes(Color{0.5, 0.45, 0.4, 1}); p.Primary.Chroma != 48.0 || p.Error != (TonalPalette{25.0, 84.0}) {
+    t.Errorf("unexpected palettes %+v", p)
+  }
+}
+
+func TestMaterialSchemeTones(t *testing.T) {
+  // The tones of Scheme.light and Scheme.dark in Material's color utilities.
+  p := NewMaterialPalettes(Color{0.4, 0.6, 0.2, 1})
+  light, dark := p.LightScheme(), p.DarkScheme()
+  tests := []struct {
+    role        string
+    palette     TonalPalette
+    light, dark Color
+    lightTone   float64
+    darkTone    float64
+  }{
+    {"primary", p.Primary, light.Primary, dark.Primary, 40, 80},
+    {"on primary", p.Primary, light.OnPrimary, dark.OnPrimary, 100, 20},
+    {"primary container", p.Primary, light.PrimaryContainer, dark.PrimaryContainer, 90, 30},
+    {"on primary container", p.Primary, light.OnPrimaryContainer, dark.OnPrimaryContainer, 10, 90},
+    {"secondary", p.Secondary, light.Secondary, dark.Secondary, 40, 80},
+    {"on secondary", p.Secondary, light.OnSecondary, dark.OnSecondary, 100, 20},
+    {"secondary container", p.Secondary, light.SecondaryContainer, dark.SecondaryContainer, 90, 30},
+    {"on secondary container", p.Secondary, light.OnSecondaryContainer, dark.OnSecondaryContainer, 10, 90},
+    {"tertiary", p.Tertiary, light.Tertiary, dark.Tertiary, 40, 80},
+    {"on tertiary", p.Tertiary, light.OnTertiary, dark.OnTertiary, 100, 20},
+    {"tertiary container", p.Tertiary, light.TertiaryContainer, dark.TertiaryContainer, 90, 30},
+    {"on tertiary container", p.Tertiary, light.OnTertiaryContainer, dark.OnTertiaryContainer, 10, 90},
+    {"error", p.Error, light.Error, dark.Error, 40, 80},
+    {"on error", p.Error, light.OnError, dark.OnError, 100, 20},
+    {"error container", p.Error, light.ErrorContainer, dark.ErrorContainer, 90, 30},
+    {"on error container", p.Error, light.OnErrorContainer, dark.OnErrorContainer, 10, 90},
+    {"background", p.Neutral, light.Background, dark.Background, 99, 10},
+    {"on background", p.Neutral, light.OnBackground, dark.OnBackground, 10, 90},
+    {"surface", p.Neutral, light.Surface, dark.Surface, 99, 10},
+    {"on surface", p.Neutral, light.OnSurface, dark.OnSurface, 10, 90},
+    {"surface variant", p.NeutralVariant, light.SurfaceVariant, dark.SurfaceVariant, 90, 30},
+    {"on surface variant", p.NeutralVariant, light.OnSurfaceVariant, dark.OnSurfaceVariant, 30, 80},
+    {"outline", p.NeutralVariant, light.Outline, dark.Outline, 50, 60},
+    {"outline variant", p.NeutralVariant, light.OutlineVariant, dark.OutlineVariant, 80, 30},
+    {"shadow", p.Neutral, light.Shadow, dark.Shadow, 0, 0},
+    {"scrim", p.Neutral, light.Scrim, dark.Scrim, 0, 0},
+    {"inverse surface", p.Neutral, light.InverseSurface, dark.InverseSurface, 20, 90},
+    {"inverse on surface", p.Neutral, light.InverseOnSurface, dark.InverseOnSurface, 95, 20},
+    {"inverse primary", p.Primary, light.InversePrimary, dark.InversePrimary, 80, 40},
+  }
+  for _, tt := range tests {
+    if got, want := tt.light.HexString(), tt.palette.Tone(tt.lightTone).HexString(); got != want {
+      t.Errorf("light %v = %v, want tone %v %v", tt.role, got, tt.lightTone, want)
+    }
+    if got, want := tt.dark.HexString(), tt.palette.Tone(tt.darkTone).HexString(); got != want {
+      t.Errorf("dark %v = %v, want tone %v %v", tt.role, got, tt.darkTone, want)
+    }
+  }
+}
@@ -4,24 +4,17 @@ import (
   "math"
 )
 
+// PaletteTo blends from c to targ in RGB, both included. Use a Gradient for
+// more control.
 func (c Color) PaletteTo(targ Color, count int) []Color {
   if count < 3 {
     count = 3
   }
 
-  inc_R := (c.R - targ.R) / float64(count)
-  inc_G := (c.G - targ.G) / float64(count)
-  inc_B := (c.B - targ.B) / float64(count)
-
   colors := make([]Color, count)
 
   for i := 0; i < count; i++ {
-    colors[i] = Color{
-      R: c.R + inc_R*float64(i),
-      G: c.G + inc_G*float64(i),
-      B: c.B + inc_B*float64(i),
-      A: 1.0,
-    }
+    colors[i] = c.BlendRgb(targ, float64(i)/float64(count-1))
   }
 
   return colors
@@ -59,3 +52,79 @@ func (c ColorHsl) PaletteToLightnessFor(min, max float64, count int) []ColorHsl
 func (c ColorHsl) PaletteToMaxLightness(count int) []ColorHsl {
   return c.PaletteToLightnessFor(0.0, 1.0, count)
 }
+
+///////////////////////////////////////////////////////////////////////////////
+/// HSLuv colors
+///////////////////////////////////////////////////////////////////////////////
+
+// PaletteToLightness steps the HSLuv lightness of c towards l in count (at least 3)
+// colors, keeping its hue and saturation. The last one has the lightness l.
+func (c ColorHsluv) PaletteToLightness(l float64, count int) []ColorHsluv {
+  if count < 3 {
+    count = 3
+  }
+
+  offset := (l - c.L) / float64(count)
+  offSum := c.L + offset
+
+  colors := make([]ColorHsluv, count)
+
+  for i := 0; i < count; i++ {
+    colors[i] = ColorHsluv{H: c.H, S: c.S, L: offSum}
+    offSum += offset
+  }
+
+  return colors
+}
+
+// PaletteToLightnessFor is PaletteToLightness towards min or max, whichever
+// is further away from the lightness of c.
+func (c ColorHsluv) PaletteToLightnessFor(min, max float64, count int) []ColorHsluv {
+  if math.Abs(max-c.L) > math.Abs(c.L-min) {
+    return c.PaletteToLightness(max, count)
+  }
+  return c.PaletteToLightness(min, count)
+}
+
+// PaletteToMaxLightness is PaletteToLightnessFor between black and white.
+func (c ColorHsluv) PaletteToMaxLightness(count int) []ColorHsluv {
+  return c.PaletteToLightnessFor(0.0, 1.0, count)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+/// HPLuv colors
+///////////////////////////////////////////////////////////////////////////////
+
+// PaletteToLightness steps the HPLuv lightness of c towards l in count (at least 3)
+// colors, keeping its hue and saturation. The last one has the lightness l.
+func (c ColorHpluv) PaletteToLightness(l float64, count int) []ColorHpluv {
+  if count < 3 {
+    count = 3
+  }
+
+  offset := (l - c.L) / float64(count)
+  offSum := c.L + offset
+
+  colors := make([]ColorHpluv, count)
+
+  for i := 0; i < count; i++ {
+    colors[i] = ColorHpluv{H: c.H, S: c.S, L: offSum}
+    offSum += offset
+  }
+
+  return colors
+}
+
+// PaletteToLightnessFor is PaletteToLightness towards min or max, whichever
+// is further away from the lightness of c.
+func (c ColorHpluv) PaletteToLightnessFor(min, max float64, count int) []ColorHpluv {
+  if math.Abs(max-c.L) > math.Abs(c.L-min) {
+    return c.PaletteToLightness(max, count)
+  }
+  return c.PaletteToLightness(min, count)
+}
+
+// PaletteToMaxLightness is PaletteToLightnessFor between black and white.
+func (c ColorHpluv) PaletteToMaxLightness(count int) []ColorHpluv {
+  return c.PaletteToLightnessFor(0.0, 1.0, count)
+}
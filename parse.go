@@ -0,0 +1,682 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package colorful
+
+import (
+  "fmt"
+  "math"
+  "strconv"
+  "strings"
+)
+
+// Parser for the CSS Color Module Level 4 syntax.
+// https://www.w3.org/TR/css-color-4/
+
+// ParseError describes why a color string could not be parsed.
+type ParseError struct {
+  Input string // The complete string given to Parse
+  Pos   int    // Byte offset of the offending token in Input
+  Token string // The offending token, empty if the input ended too early
+  Msg   string
+}
+
+func (e *ParseError) Error() string {
+  if e.Token == "" {
+    return fmt.Sprintf("color: %s at end of %q", e.Msg, e.Input)
+  }
+  return fmt.Sprintf("color: %s: %q at offset %d of %q", e.Msg, e.Token, e.Pos, e.Input)
+}
+
+// Parse parses any CSS Color 4 color: hex colors, named colors, transparent,
+// rgb(), rgba(), hsl(), hsla(), hwb(), lab(), lch(), oklab(), oklch() and
+// color(). Both the modern space separated syntax with an optional "/ alpha"
+// and the legacy comma separated one are understood, as are percentages,
+// angles in deg, rad, grad or turn and the none keyword.
+//
+// lab() and lch() are relative to D50 as in CSS and get adapted to D65.
+// Colors outside of the sRGB gamut are not clamped, use IsValid and Clamped.
+func Parse(s string) (Color, error) {
+  p := &cssParser{input: s}
+  if err := p.tokenize(); err != nil {
+    return Color{}, err
+  }
+
+  col, err := p.color()
+  if err != nil {
+    return Color{}, err
+  }
+  if tok := p.next(); tok.kind != tokEOF {
+    return Color{}, p.errorAt(tok, "unexpected token after color")
+  }
+  return col, nil
+}
+
+///////////////////////////////////////////////////////////////////////////////
+/// Tokenizer
+///////////////////////////////////////////////////////////////////////////////
+
+const (
+  tokEOF = iota
+  tokNumber
+  tokPercent
+  tokDimension
+  tokIdent
+  tokFunction
+  tokHash
+  tokComma
+  tokSlash
+  tokRParen
+)
+
+type cssToken struct {
+  kind  int
+  pos   int
+  text  string  // Raw text of the token
+  value float64 // Numeric value of numbers, percentages and dimensions
+  unit  string  // Lower-cased unit of dimensions, name of idents and functions
+}
+
+type cssParser struct {
+  input  string
+  tokens []cssToken
+  cur    int
+}
+
+func isDigit(c byte) bool {
+  return '0' <= c && c <= '9'
+}
+
+func isNameChar(c byte) bool {
+  return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '-' || c == '_' || isDigit(c)
+}
+
+func (p *cssParser) tokenize() error {
+  s := p.input
+  for i := 0; ; {
+    for i < len(s) && strings.IndexByte(" \t\n\r\f", s[i]) >= 0 {
+      i++
+    }
+    if i >= len(s) {
+      p.tokens = append(p.tokens, cssToken{kind: tokEOF, pos: i})
+      return nil
+    }
+
+    start := i
+    c := s[i]
+    switch {
+    case c == ',':
+      p.tokens = append(p.tokens, cssToken{kind: tokComma, pos: i, text: ","})
+      i++
+    case c == '/':
+      p.tokens = append(p.tokens, cssToken{kind: tokSlash, pos: i, text: "/"})
+      i++
+    case c == ')':
+      p.tokens = append(p.tokens, cssToken{kind: tokRParen, pos: i, text: ")"})
+      i++
+    case c == '#':
+      for i++; i < len(s) && isNameChar(s[i]); i++ {
+      }
+      p.tokens = append(p.tokens, cssToken{kind: tokHash, pos: start, text: s[start:i]})
+    case isDigit(c) || c == '.' || ((c == '+' || c == '-') && i+1 < len(s) && (isDigit(s[i+1]) || s[i+1] == '.')):
+      if c == '+' || c == '-' {
+        i++
+      }
+      for ; i < len(s) && isDigit(s[i]); i++ {
+      }
+      if i < len(s) && s[i] == '.' {
+        for i++; i < len(s) && isDigit(s[i]); i++ {
+        }
+      }
+      // Only take the exponent if there actually are digits, "1e" is a dimension.
+      if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+        j := i + 1
+        if j < len(s) && (s[j] == '+' || s[j] == '-') {
+          j++
+        }
+        if j < len(s) && isDigit(s[j]) {
+          for i = j; i < len(s) && isDigit(s[i]); i++ {
+          }
+        }
+      }
+      v, err := strconv.ParseFloat(s[start:i], 64)
+      if err != nil {
+        return &ParseError{p.input, start, s[start:i], "invalid number"}
+      }
+
+      tok := cssToken{kind: tokNumber, pos: start, value: v}
+      if i < len(s) && s[i] == '%' {
+        tok.kind = tokPercent
+        i++
+      } else if i < len(s) && isNameChar(s[i]) {
+        u := i
+        for ; i < len(s) && isNameChar(s[i]); i++ {
+        }
+        tok.kind = tokDimension
+        tok.unit = strings.ToLower(s[u:i])
+      }
+      tok.text = s[start:i]
+      p.tokens = append(p.tokens, tok)
+    case isNameChar(c):
+      for ; i < len(s) && isNameChar(s[i]); i++ {
+      }
+      tok := cssToken{kind: tokIdent, pos: start, unit: strings.ToLower(s[start:i])}
+      if i < len(s) && s[i] == '(' {
+        tok.kind = tokFunction
+        i++
+      }
+      tok.text = s[start:i]
+      p.tokens = append(p.tokens, tok)
+    default:
+      return &ParseError{p.input, i, s[i : i+1], "unexpected character"}
+    }
+  }
+}
+
+func (p *cssParser) next() cssToken {
+  tok := p.tokens[p.cur]
+  if tok.kind != tokEOF {
+    p.cur++
+  }
+  return tok
+}
+
+func (p *cssParser) errorAt(tok cssToken, msg string) *ParseError {
+  return &ParseError{p.input, tok.pos, tok.text, msg}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+/// Grammar
+///////////////////////////////////////////////////////////////////////////////
+
+func (p *cssParser) color() (Color, error) {
+  tok := p.next()
+  switch tok.kind {
+  case tokHash:
+    col, err := Hex(tok.text)
+    if err != nil {
+      return Color{}, p.errorAt(tok, "invalid hex color")
+    }
+    return col, nil
+  case tokIdent:
+    if tok.unit == "transparent" {
+      return Color{0.0, 0.0, 0.0, 0.0}, nil
+    }
+    if col, ok := Named(tok.unit); ok {
+      return col, nil
+    }
+    return Color{}, p.errorAt(tok, "unknown color name")
+  case tokFunction:
+    switch tok.unit {
+    case "rgb", "rgba":
+      return p.rgb()
+    case "hsl", "hsla":
+      return p.hsl()
+    case "hwb":
+      return p.hwb()
+    case "lab":
+      return p.lab()
+    case "lch":
+      return p.lch()
+    case "oklab":
+      return p.oklab()
+    case "oklch":
+      return p.oklch()
+    case "color":
+      return p.colorFunc()
+    }
+    return Color{}, p.errorAt(tok, "unknown color function")
+  }
+  return Color{}, p.errorAt(tok, "expected a color")
+}
+
+// cssArgs are the arguments of a color function, without the color space of color().
+type cssArgs struct {
+  comps  []cssToken
+  alpha  *cssToken
+  legacy bool
+  end    cssToken // The closing parenthesis
+}
+
+func isValueToken(tok cssToken) bool {
+  return tok.kind == tokNumber || tok.kind == tokPercent || tok.kind == tokDimension || tok.kind == tokIdent
+}
+
+// args reads the arguments up to and including the closing parenthesis, in
+// either the modern "a b c / alpha" or the legacy "a, b, c, alpha" form.
+func (p *cssParser) args() (args cssArgs, err error) {
+  slash := false
+  for {
+    tok := p.next()
+    switch {
+    case tok.kind == tokRParen:
+      if slash && args.alpha == nil {
+        return args, p.errorAt(tok, "missing alpha after /")
+      }
+      args.end = tok
+      return args, nil
+    case tok.kind == tokEOF:
+      return args, p.errorAt(tok, "missing )")
+    case tok.kind == tokComma:
+      if slash || len(args.comps) == 0 || (!args.legacy && len(args.comps) > 1) {
+        return args, p.errorAt(tok, "unexpected comma")
+      }
+      args.legacy = true
+      if val := p.next(); !isValueToken(val) {
+        return args, p.errorAt(val, "expected a value after comma")
+      } else if len(args.comps) == 3 && args.alpha == nil {
+        args.alpha = &val
+      } else if len(args.comps) < 3 {
+        args.comps = append(args.comps, val)
+      } else {
+        return args, p.errorAt(val, "too many values")
+      }
+    case tok.kind == tokSlash:
+      if slash || args.legacy {
+        return args, p.errorAt(tok, "unexpected /")
+      }
+      slash = true
+    case isValueToken(tok):
+      if args.legacy {
+        return args, p.errorAt(tok, "missing comma")
+      }
+      if slash {
+        if args.alpha != nil {
+          return args, p.errorAt(tok, "too many values")
+        }
+        args.alpha = &tok
+      } else {
+        args.comps = append(args.comps, tok)
+      }
+    default:
+      return args, p.errorAt(tok, "unexpected token")
+    }
+  }
+}
+
+// argsN reads the arguments and makes sure there are exactly n components.
+func (p *cssParser) argsN(n int, legacyOk bool) (cssArgs, error) {
+  args, err := p.args()
+  if err != nil {
+    return args, err
+  }
+  if args.legacy && !legacyOk {
+    return args, p.errorAt(args.end, "comma separated syntax is not allowed here")
+  }
+  if len(args.comps) != n {
+    return args, p.errorAt(args.end, fmt.Sprintf("expected %d values", n))
+  }
+
+  // The legacy syntax doesn't know about none.
+  if args.legacy {
+    for _, tok := range args.comps {
+      if tok.kind == tokIdent {
+        return args, p.errorAt(tok, "none is not allowed in comma separated syntax")
+      }
+    }
+    if args.alpha != nil && args.alpha.kind == tokIdent {
+      return args, p.errorAt(*args.alpha, "none is not allowed in comma separated syntax")
+    }
+  }
+  return args, nil
+}
+
+// number reads a number or percentage, where 100% corresponds to ref.
+func (p *cssParser) number(tok cssToken, ref float64) (float64, error) {
+  switch tok.kind {
+  case tokNumber:
+    return tok.value, nil
+  case tokPercent:
+    return tok.value / 100.0 * ref, nil
+  case tokIdent:
+    if tok.unit == "none" {
+      return 0.0, nil
+    }
+  }
+  return 0.0, p.errorAt(tok, "expected a number or percentage")
+}
+
+// hue reads an angle in degrees and normalizes it into [0..360).
+func (p *cssParser) hue(tok cssToken) (h float64, err error) {
+  switch tok.kind {
+  case tokNumber:
+    h = tok.value
+  case tokDimension:
+    switch tok.unit {
+    case "deg":
+      h = tok.value
+    case "rad":
+      h = tok.value * 180.0 / math.Pi
+    case "grad":
+      h = tok.value * 0.9
+    case "turn":
+      h = tok.value * 360.0
+    default:
+      return 0.0, p.errorAt(tok, "unknown angle unit")
+    }
+  case tokIdent:
+    if tok.unit != "none" {
+      return 0.0, p.errorAt(tok, "expected a hue")
+    }
+  default:
+    return 0.0, p.errorAt(tok, "expected a hue")
+  }
+
+  h = math.Mod(h, 360.0)
+  if h < 0.0 {
+    h += 360.0
+  }
+  return h, nil
+}
+
+func (p *cssParser) alpha(args cssArgs) (float64, error) {
+  if args.alpha == nil {
+    return 1.0, nil
+  }
+  a, err := p.number(*args.alpha, 1.0)
+  return clamp01(a), err
+}
+
+// numbers reads all components with the given references for percentages.
+func (p *cssParser) numbers(args cssArgs, refs ...float64) (v [3]float64, err error) {
+  for i, tok := range args.comps {
+    if v[i], err = p.number(tok, refs[i]); err != nil {
+      return
+    }
+  }
+  return
+}
+
+func (p *cssParser) rgb() (Color, error) {
+  args, err := p.argsN(3, true)
+  if err != nil {
+    return Color{}, err
+  }
+
+  // In the legacy syntax all values need to be of the same kind.
+  if args.legacy {
+    for _, tok := range args.comps[1:] {
+      if tok.kind != args.comps[0].kind {
+        return Color{}, p.errorAt(tok, "cannot mix numbers and percentages")
+      }
+    }
+  }
+
+  v, err := p.numbers(args, 255.0, 255.0, 255.0)
+  if err != nil {
+    return Color{}, err
+  }
+  a, err := p.alpha(args)
+  return Color{clamp01(v[0] / 255.0), clamp01(v[1] / 255.0), clamp01(v[2] / 255.0), a}, err
+}
+
+func (p *cssParser) hsl() (Color, error) {
+  args, err := p.argsN(3, true)
+  if err != nil {
+    return Color{}, err
+  }
+  if args.legacy {
+    for _, tok := range args.comps[1:] {
+      if tok.kind != tokPercent {
+        return Color{}, p.errorAt(tok, "expected a percentage")
+      }
+    }
+  }
+
+  h, err := p.hue(args.comps[0])
+  if err != nil {
+    return Color{}, err
+  }
+  v, err := p.numbers(cssArgs{comps: args.comps[1:]}, 100.0, 100.0)
+  if err != nil {
+    return Color{}, err
+  }
+  a, err := p.alpha(args)
+
+  r, g, b := hslToRgb(h, clamp01(v[0]/100.0), clamp01(v[1]/100.0))
+  return Color{r, g, b, a}, err
+}
+
+func (p *cssParser) hwb() (Color, error) {
+  args, err := p.argsN(3, false)
+  if err != nil {
+    return Color{}, err
+  }
+
+  h, err := p.hue(args.comps[0])
+  if err != nil {
+    return Color{}, err
+  }
+  v, err := p.numbers(cssArgs{comps: args.comps[1:]}, 100.0, 100.0)
+  if err != nil {
+    return Color{}, err
+  }
+  a, err := p.alpha(args)
+
+  r, g, b := hwbToRgb(h, clamp01(v[0]/100.0), clamp01(v[1]/100.0))
+  return Color{r, g, b, a}, err
+}
+
+func (p *cssParser) lab() (Color, error) {
+  args, err := p.argsN(3, false)
+  if err != nil {
+    return Color{}, err
+  }
+  v, err := p.numbers(args, 100.0, 125.0, 125.0)
+  if err != nil {
+    return Color{}, err
+  }
+  a, err := p.alpha(args)
+
+  col := cssLabToColor(ColorLab{clamp01(v[0] / 100.0), v[1] / 100.0, v[2] / 100.0})
+  col.A = a
+  return col, err
+}
+
+func (p *cssParser) lch() (Color, error) {
+  args, err := p.argsN(3, false)
+  if err != nil {
+    return Color{}, err
+  }
+  v, err := p.numbers(cssArgs{comps: args.comps[:2]}, 100.0, 150.0)
+  if err != nil {
+    return Color{}, err
+  }
+  h, err := p.hue(args.comps[2])
+  if err != nil {
+    return Color{}, err
+  }
+  a, err := p.alpha(args)
+
+  lch := ColorLch{clamp01(v[0] / 100.0), math.Max(0.0, v[1]/100.0), h}
+  col := cssLabToColor(lch.Lab())
+  col.A = a
+  return col, err
+}
+
+func (p *cssParser) oklab() (Color, error) {
+  args, err := p.argsN(3, false)
+  if err != nil {
+    return Color{}, err
+  }
+  v, err := p.numbers(args, 1.0, 0.4, 0.4)
+  if err != nil {
+    return Color{}, err
+  }
+  a, err := p.alpha(args)
+
+  col := ColorOkLab{clamp01(v[0]), v[1], v[2]}.Color()
+  col.A = a
+  return col, err
+}
+
+func (p *cssParser) oklch() (Color, error) {
+  args, err := p.argsN(3, false)
+  if err != nil {
+    return Color{}, err
+  }
+  v, err := p.numbers(cssArgs{comps: args.comps[:2]}, 1.0, 0.4)
+  if err != nil {
+    return Color{}, err
+  }
+  h, err := p.hue(args.comps[2])
+  if err != nil {
+    return Color{}, err
+  }
+  a, err := p.alpha(args)
+
+  col := ColorOkLch{clamp01(v[0]), math.Max(0.0, v[1]), h}.Color()
+  col.A = a
+  return col, err
+}
+
+func (p *cssParser) colorFunc() (Color, error) {
+  tok := p.next()
+  if tok.kind != tokIdent {
+    return Color{}, p.errorAt(tok, "expected a color space")
+  }
+  space, ok := cssColorSpaces[tok.unit]
+  if !ok {
+    return Color{}, p.errorAt(tok, "unknown color space")
+  }
+
+  args, err := p.argsN(3, false)
+  if err != nil {
+    return Color{}, err
+  }
+  v, err := p.numbers(args, 1.0, 1.0, 1.0)
+  if err != nil {
+    return Color{}, err
+  }
+  a, err := p.alpha(args)
+
+  col := space(v[0], v[1], v[2])
+  col.A = a
+  return col, err
+}
+
+///////////////////////////////////////////////////////////////////////////////
+/// Conversions
+///////////////////////////////////////////////////////////////////////////////
+
+// hslToRgb is like ColorHsl.Color but doesn't round to 8 bits.
+func hslToRgb(h, s, l float64) (r, g, b float64) {
+  if s == 0.0 {
+    return l, l, l
+  }
+  var q float64
+  if l < 0.5 {
+    q = l * (1.0 + s)
+  } else {
+    q = l + s - l*s
+  }
+  p := 2.0*l - q
+  h /= 360.0
+  return hue2rgb(p, q, h+1.0/3.0), hue2rgb(p, q, h), hue2rgb(p, q, h-1.0/3.0)
+}
+
+// https://www.w3.org/TR/css-color-4/#hwb-to-rgb
+func hwbToRgb(h, w, b float64) (float64, float64, float64) {
+  if w+b >= 1.0 {
+    gray := w / (w + b)
+    return gray, gray, gray
+  }
+  r, g, bl := hslToRgb(h, 1.0, 0.5)
+  f := 1.0 - w - b
+  return r*f + w, g*f + w, bl*f + w
+}
+
+// Bradford chromatic adaptation between the D50 and D65 white points, as in CSS.
+var bradfordD50ToD65 = [3][3]float64{
+  {0.9554734527042182, -0.023098536874261423, 0.0632593086610217},
+  {-0.028369706963208136, 1.0099954580058226, 0.021041398966943008},
+  {0.012314001688319899, -0.020507696433477912, 1.3303659366080753},
+}
+
+var bradfordD65ToD50 = [3][3]float64{
+  {1.0479298208405488, 0.022946793341019088, -0.05019222954313557},
+  {0.029627815688159344, 0.990434484573249, -0.01707382502938514},
+  {-0.009243058152591178, 0.015055144896577895, 0.7518742899580008},
+}
+
+func mulMat3(m [3][3]float64, x, y, z float64) (float64, float64, float64) {
+  return m[0][0]*x + m[0][1]*y + m[0][2]*z,
+    m[1][0]*x + m[1][1]*y + m[1][2]*z,
+    m[2][0]*x + m[2][1]*y + m[2][2]*z
+}
+
+// The D50 white point as CSS defines it, which is slightly off the D50 used elsewhere.
+var cssD50 = [3]float64{0.3457 / 0.3585, 1.0, (1.0 - 0.3457 - 0.3585) / 0.3585}
+
+// cssLabToColor converts CSS Lab, which is relative to D50, to a (D65) sRGB color.
+func cssLabToColor(lab ColorLab) Color {
+  xyz := lab.XyzWhiteRef(cssD50)
+  x, y, z := mulMat3(bradfordD50ToD65, xyz.X, xyz.Y, xyz.Z)
+  return ColorXyz{x, y, z}.Color()
+}
+
+// xyzToCssLab converts D65 XYZ to CSS Lab, the inverse of cssLabToColor.
+func xyzToCssLab(xyz ColorXyz) ColorLab {
+  x, y, z := mulMat3(bradfordD65ToD50, xyz.X, xyz.Y, xyz.Z)
+  return ColorXyz{x, y, z}.LabWhiteRef(cssD50)
+}
+
+// signed applies a transfer function to the magnitude and keeps the sign, as CSS does.
+func signed(f func(float64) float64, v float64) float64 {
+  if v < 0.0 {
+    return -f(-v)
+  }
+  return f(v)
+}
+
+// xyzD50ToColor converts CSS XYZ relative to D50 to a (D65) sRGB color.
+func xyzD50ToColor(x, y, z float64) Color {
+  x, y, z = mulMat3(bradfordD50ToD65, x, y, z)
+  return signedXyzToColor(ColorXyz{x, y, z})
+}
+
+func xyzToColor(x, y, z float64) Color {
+  return signedXyzToColor(ColorXyz{x, y, z})
+}
+
+// spaceToColor maps the values of one of the RGB spaces to sRGB.
+func spaceToColor(space *RGBSpace) func(r, g, b float64) Color {
+  return func(r, g, b float64) Color {
+    return FromSpace(space, r, g, b)
+  }
+}
+
+// The predefined color spaces of color(), mapping their values to sRGB.
+// https://www.w3.org/TR/css-color-4/#predefined
+var cssColorSpaces = map[string]func(r, g, b float64) Color{
+  "srgb": func(r, g, b float64) Color {
+    return Color{r, g, b, 1.0}
+  },
+  "srgb-linear": func(r, g, b float64) Color {
+    return Color{signed(delinearize, r), signed(delinearize, g), signed(delinearize, b), 1.0}
+  },
+  "display-p3":   spaceToColor(DisplayP3),
+  "a98-rgb":      spaceToColor(AdobeRGB),
+  "prophoto-rgb": spaceToColor(ProPhotoRGB),
+  "rec2020":      spaceToColor(Rec2020),
+  "xyz":          xyzToColor,
+  "xyz-d65":      xyzToColor,
+  "xyz-d50":      xyzD50ToColor,
+}
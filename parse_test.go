@@ -0,0 +1,112 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+func almostEqualColor(c1, c2 Color, eps float64) bool {
+  return math.Abs(c1.R-c2.R) < eps && math.Abs(c1.G-c2.G) < eps &&
+    math.Abs(c1.B-c2.B) < eps && math.Abs(c1.A-c2.A) < eps
+}
+
+var parseVals = []struct {
+  css string
+  c   Color
+}{
+  {"#f00", Color{1.0, 0.0, 0.0, 1.0}},
+  {"#FF000080", Color{1.0, 0.0, 0.0, 128.0 / 255.0}},
+  {"red", Color{1.0, 0.0, 0.0, 1.0}},
+  {"RebeccaPurple", RGB(0x66, 0x33, 0x99)},
+  {"transparent", Color{0.0, 0.0, 0.0, 0.0}},
+  {"rgb(255, 0, 0)", Color{1.0, 0.0, 0.0, 1.0}},
+  {"rgba(255,0,0,0.5)", Color{1.0, 0.0, 0.0, 0.5}},
+  {"rgb(100%, 50%, 0%)", Color{1.0, 0.5, 0.0, 1.0}},
+  {"rgb(255 0 0 / 50%)", Color{1.0, 0.0, 0.0, 0.5}},
+  {"rgb(255 none 0)", Color{1.0, 0.0, 0.0, 1.0}},
+  {"rgb(300 -10 0)", Color{1.0, 0.0, 0.0, 1.0}},
+  {"RGB(1e2% 0 0)", Color{1.0, 0.0, 0.0, 1.0}},
+  {"hsl(120, 100%, 50%)", Color{0.0, 1.0, 0.0, 1.0}},
+  {"hsla(240, 100%, 50%, .25)", Color{0.0, 0.0, 1.0, 0.25}},
+  {"hsl(0.5turn 100% 25%)", Color{0.0, 0.5, 0.5, 1.0}},
+  {"hsl(3.14159265rad 100 25)", Color{0.0, 0.5, 0.5, 1.0}},
+  {"hsl(200grad 100% 25%)", Color{0.0, 0.5, 0.5, 1.0}},
+  {"hsl(-180deg 100% 25%)", Color{0.0, 0.5, 0.5, 1.0}},
+  {"hwb(0 0% 0%)", Color{1.0, 0.0, 0.0, 1.0}},
+  {"hwb(120 20% 20%)", Color{0.2, 0.8, 0.2, 1.0}},
+  {"hwb(0 60% 60%)", Color{0.5, 0.5, 0.5, 1.0}},
+  // Values from the examples of the CSS Color 4 specification.
+  {"lab(29.2345% 39.3825 20.0664)", RGB(125, 35, 41)},
+  {"lab(52.2345% 40.1645 59.9971 / .5)", Color{198.0 / 255.0, 93.0 / 255.0, 6.0 / 255.0, 0.5}},
+  {"lch(29.2345% 44.2 27)", RGB(125, 35, 41)},
+  {"lch(52.2345% 72.2 56.2)", RGB(198, 93, 6)},
+  {"oklab(40.101% 0.1147 0.0453)", RGB(125, 35, 41)},
+  {"oklch(40.101% 0.12332 21.555)", RGB(125, 35, 41)},
+  {"oklch(59.686% 0.15619 49.7694)", RGB(198, 93, 6)},
+  {"oklab(1 0 0)", Color{1.0, 1.0, 1.0, 1.0}},
+  {"color(srgb 1 0.5 0 / 0.5)", Color{1.0, 0.5, 0.0, 0.5}},
+  {"color(srgb-linear 0.21404 0.21404 0.21404)", Color{0.5, 0.5, 0.5, 1.0}},
+  {"color(display-p3 1 1 1)", Color{1.0, 1.0, 1.0, 1.0}},
+  {"color(display-p3 0.91749 0.20029 0.13856)", Color{1.0, 0.0, 0.0, 1.0}},
+  {"color(a98-rgb 0.85859 0 0)", Color{1.0, 0.0, 0.0, 1.0}},
+  {"color(rec2020 0.79198 0.23098 0.07376)", Color{1.0, 0.0, 0.0, 1.0}},
+  {"color(prophoto-rgb 0.70225 0.27572 0.10355)", Color{1.0, 0.0, 0.0, 1.0}},
+  {"color(xyz-d65 0.95047 1 1.08883)", Color{1.0, 1.0, 1.0, 1.0}},
+  {"color(xyz-d50 0.96422 1 0.82521)", Color{1.0, 1.0, 1.0, 1.0}},
+  // Outside of sRGB, from the CSS Color 4 sample code.
+  {"color(display-p3 1 0 0)", Color{1.0930, -0.2267, -0.1501, 1.0}},
+  {"color(rec2020 0 1 0)", Color{-0.7903, 1.0563, -0.3502, 1.0}},
+}
+
+func TestParse(t *testing.T) {
+  for _, tt := range parseVals {
+    c, err := Parse(tt.css)
+    if err != nil {
+      t.Errorf("Parse(%q) failed: %v", tt.css, err)
+    } else if !almostEqualColor(c, tt.c, 2.0/255.0) {
+      t.Errorf("Parse(%q) => %v, want %v", tt.css, c, tt.c)
+    }
+  }
+}
+
+var parseErrors = []struct {
+  css   string
+  pos   int
+  token string
+}{
+  {"", 0, ""},
+  {"#ggg", 0, "#ggg"},
+  {"bluish", 0, "bluish"},
+  {"rgb(255 0)", 9, ")"},
+  {"rgb(255, 0 0)", 11, "0"},
+  {"rgb(255 0, 0)", 9, ","},
+  {"rgb(255, 0%, 0)", 9, "0%"},
+  {"rgb(255, none, 0)", 9, "none"},
+  {"rgb(255 0 0 / )", 14, ")"},
+  {"rgb(255 0 0", 11, ""},
+  {"hwb(0, 0%, 0%)", 13, ")"},
+  {"hsl(10foo 50% 50%)", 4, "10foo"},
+  {"hsl(10 50% 50%) x", 16, "x"},
+  {"color(foo 1 1 1)", 6, "foo"},
+  {"rgb(255 0 0 ; 1)", 12, ";"},
+  {"cmyk(0 0 0 0)", 0, "cmyk("},
+  {"rgba(1,2,3,0.5,0.7)", 15, "0.7"},
+}
+
+func TestParseErrors(t *testing.T) {
+  for _, tt := range parseErrors {
+    _, err := Parse(tt.css)
+    perr, ok := err.(*ParseError)
+    if !ok {
+      t.Errorf("Parse(%q) should fail with a *ParseError, got %v", tt.css, err)
+      continue
+    }
+    if perr.Pos != tt.pos || perr.Token != tt.token {
+      t.Errorf("Parse(%q) error points at %q (%d), want %q (%d): %v", tt.css, perr.Token, perr.Pos, tt.token, tt.pos, err)
+    }
+  }
+}
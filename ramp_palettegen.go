@@ -0,0 +1,170 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+)
+
+// The palettes are made in HCL, with L* in [0..1] and chroma in about [0..1.3],
+// going from light to dark. Chroma is lowered where it doesn't fit into sRGB,
+// which keeps the lightness, and thus its order.
+
+type SequentialSettings struct {
+  // The HCL hue in [0..360].
+  Hue float64
+
+  // The lightness L* of the lightest and of the darkest color.
+  Light float64
+  Dark  float64
+
+  // The chroma of the lightest and of the darkest color, and the one of the
+  // most colorful in between if it's higher than both.
+  LightChroma float64
+  DarkChroma  float64
+  MaxChroma   float64
+
+  // Above 1, lightness changes slowly first, leaving more light colors.
+  // 0 or less means 1, a linear change.
+  Power float64
+}
+
+// Single hue ramps from almost white to dark, like the ColorBrewer ones.
+var DefaultSequential = SequentialSettings{
+  Light:       0.97,
+  Dark:        0.25,
+  LightChroma: 0.05,
+  DarkChroma:  0.5,
+  MaxChroma:   0.75,
+  Power:       1.3,
+}
+
+// SequentialPaletteEx makes a palette for ordered data, from light to dark.
+// It is monotonic in lightness.
+func SequentialPaletteEx(colorsCount int, settings SequentialSettings) []Color {
+  colors := make([]Color, 0, colorsCount)
+  for i := 0; i < colorsCount; i++ {
+    l, c := settings.ramp(classPos(i, colorsCount))
+    colors = append(colors, hclInGamut(ColorHcl{settings.Hue, c, l}))
+  }
+  return colors
+}
+
+// A wrapper which uses the default settings with the hue.
+func SequentialPalette(hue float64, colorsCount int) []Color {
+  settings := DefaultSequential
+  settings.Hue = hue
+  return SequentialPaletteEx(colorsCount, settings)
+}
+
+// ramp gives the lightness and the chroma at t in [0..1] from light to dark.
+func (settings SequentialSettings) ramp(t float64) (l, c float64) {
+  power := settings.Power
+  if power <= 0.0 {
+    power = 1.0
+  }
+  l = settings.Light + (settings.Dark-settings.Light)*math.Pow(t, power)
+
+  c0, c1, max := settings.LightChroma, settings.DarkChroma, settings.MaxChroma
+  if max <= c0 || max <= c1 {
+    return l, c0 + (c1-c0)*t
+  }
+
+  // A triangle peaking where both sides are equally steep.
+  peak := (max - c0) / (2.0*max - c0 - c1)
+  if t <= peak {
+    return l, c0 + (max-c0)*t/peak
+  }
+  return l, max + (c1-max)*(t-peak)/(1.0-peak)
+}
+
+type DivergingSettings struct {
+  // The HCL hues in [0..360] of the first and of the last color.
+  Hue1 float64
+  Hue2 float64
+
+  // The lightness L* of the neutral middle and of both ends.
+  Light float64
+  Dark  float64
+
+  // The chroma at both ends, and the one of the most colorful in between if
+  // it's higher. The middle is gray.
+  Chroma    float64
+  MaxChroma float64
+
+  // Above 1, lightness changes slowly first, leaving more light colors.
+  // 0 or less means 1, a linear change.
+  Power float64
+}
+
+// Diverging ramps through a light gray, like the ColorBrewer ones.
+var DefaultDiverging = DivergingSettings{
+  Light:     0.95,
+  Dark:      0.3,
+  Chroma:    0.5,
+  MaxChroma: 0.8,
+  Power:     1.3,
+}
+
+// DivergingPaletteEx makes a palette for data around a critical value, from
+// dark in the first hue to a neutral middle to dark in the second hue.
+// Lightness and chroma are symmetrical: both sides take the chroma that fits
+// into sRGB for both hues.
+func DivergingPaletteEx(colorsCount int, settings DivergingSettings) []Color {
+  arm := SequentialSettings{
+    Light:       settings.Light,
+    Dark:        settings.Dark,
+    LightChroma: 0.0,
+    DarkChroma:  settings.Chroma,
+    MaxChroma:   settings.MaxChroma,
+    Power:       settings.Power,
+  }
+
+  colors := make([]Color, 0, colorsCount)
+  for i := 0; i < colorsCount; i++ {
+    // From -1 at the first color over 0 at the middle to 1 at the last color.
+    x := 2.0*classPos(i, colorsCount) - 1.0
+    l, c := arm.ramp(math.Abs(x))
+    c = math.Min(hclMaxChroma(ColorHcl{settings.Hue1, c, l}), hclMaxChroma(ColorHcl{settings.Hue2, c, l}))
+    h := settings.Hue2
+    if x < 0.0 {
+      h = settings.Hue1
+    }
+    colors = append(colors, ColorHcl{h, c, l}.Color().Clamped())
+  }
+  return colors
+}
+
+// A wrapper which uses the default settings with the hues.
+func DivergingPalette(hue1, hue2 float64, colorsCount int) []Color {
+  settings := DefaultDiverging
+  settings.Hue1, settings.Hue2 = hue1, hue2
+  return DivergingPaletteEx(colorsCount, settings)
+}
+
+// classPos is the position in [0..1] of the i-th of n classes.
+func classPos(i, n int) float64 {
+  if n < 2 {
+    return 0.5
+  }
+  return float64(i) / float64(n-1)
+}
@@ -0,0 +1,91 @@
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+func TestSequentialPalette(t *testing.T) {
+  for _, hue := range []float64{0, 60, 130, 250, 300} {
+    for _, n := range []int{3, 5, 9} {
+      pal := SequentialPalette(hue, n)
+      if len(pal) != n {
+        t.Fatalf("hue %v: got %v colors, want %v", hue, len(pal), n)
+      }
+      for i, c := range pal {
+        if !c.IsValid() {
+          t.Errorf("hue %v: color %v isn't valid: %v", hue, i, c)
+        }
+        hcl := c.Hcl()
+        if hcl.C > 0.05 && math.Abs(math.Mod(hcl.H-hue+540.0, 360.0)-180.0) > 1.0 {
+          t.Errorf("hue %v: color %v has hue %v", hue, i, hcl.H)
+        }
+        if i > 0 && c.Lab().L >= pal[i-1].Lab().L {
+          t.Errorf("hue %v: color %v isn't darker than the previous one", hue, i)
+        }
+      }
+      if l := pal[0].Lab().L; math.Abs(l-DefaultSequential.Light) > 1e-3 {
+        t.Errorf("hue %v: lightest has L* %v", hue, l)
+      }
+      if l := pal[n-1].Lab().L; math.Abs(l-DefaultSequential.Dark) > 1e-3 {
+        t.Errorf("hue %v: darkest has L* %v", hue, l)
+      }
+    }
+  }
+}
+
+func TestSequentialRamp(t *testing.T) {
+  settings := SequentialSettings{Light: 0.9, Dark: 0.3, LightChroma: 0.1, DarkChroma: 0.3, MaxChroma: 0.5, Power: 1.0}
+  tests := []struct{ t, l, c float64 }{
+    {0.0, 0.9, 0.1},
+    {0.5, 0.6, 0.4},
+    {2.0 / 3.0, 0.5, 0.5},
+    {1.0, 0.3, 0.3},
+  }
+  for _, tt := range tests {
+    if l, c := settings.ramp(tt.t); math.Abs(l-tt.l) > 1e-9 || math.Abs(c-tt.c) > 1e-9 {
+      t.Errorf("ramp(%v) = %v, %v, want %v, %v", tt.t, l, c, tt.l, tt.c)
+    }
+  }
+
+  // No peak without a higher maximum.
+  settings.MaxChroma = 0.0
+  if _, c := settings.ramp(0.5); math.Abs(c-0.2) > 1e-9 {
+    t.Errorf("ramp(0.5) has chroma %v, want 0.2", c)
+  }
+
+  // Without a power, lightness changes linearly.
+  for _, power := range []float64{0.0, -1.0} {
+    settings.Power = power
+    if l, _ := settings.ramp(0.5); math.Abs(l-0.6) > 1e-9 {
+      t.Errorf("ramp(0.5) with power %v has lightness %v, want 0.6", power, l)
+    }
+  }
+}
+
+func TestDivergingPalette(t *testing.T) {
+  for _, n := range []int{4, 7, 11} {
+    // Blue has much more room in sRGB than yellow at dark lightness.
+    pal := DivergingPalette(260, 80, n)
+    if len(pal) != n {
+      t.Fatalf("got %v colors, want %v", len(pal), n)
+    }
+    for i := 0; i < n/2; i++ {
+      hcl1, hcl2 := pal[i].Hcl(), pal[n-1-i].Hcl()
+      if math.Abs(hcl1.L-hcl2.L) > 1e-3 || math.Abs(hcl1.C-hcl2.C) > 1e-3 {
+        t.Errorf("n=%v: colors %v and %v aren't symmetrical: %v and %v", n, i, n-1-i, hcl1, hcl2)
+      }
+      if math.Abs(hcl1.H-260) > 1.0 || math.Abs(hcl2.H-80) > 1.0 {
+        t.Errorf("n=%v: colors %v and %v have hues %v and %v", n, i, n-1-i, hcl1.H, hcl2.H)
+      }
+      if i > 0 && hcl1.L <= pal[i-1].Lab().L {
+        t.Errorf("n=%v: color %v isn't lighter than the previous one", n, i)
+      }
+    }
+    if n%2 == 1 {
+      if hcl := pal[n/2].Hcl(); hcl.C > 1e-3 || math.Abs(hcl.L-DefaultDiverging.Light) > 1e-3 {
+        t.Errorf("n=%v: middle isn't a light gray: %v", n, hcl)
+      }
+    }
+  }
+}
@@ -0,0 +1,207 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+)
+
+// RGBSpace describes an RGB color space by the chromaticities of its
+// primaries and white point, and its transfer function. Color itself is
+// always sRGB; use Color.In and FromSpace to convert between the two.
+type RGBSpace struct {
+  Name string
+
+  // The xy chromaticities of the primaries and the white point.
+  Red, Green, Blue, White [2]float64
+
+  // Decode turns encoded values into linear light, Encode does the reverse.
+  // Both are nil for linear spaces. Negative values are mirrored, so colors
+  // outside of the gamut survive the round trip.
+  Decode, Encode func(v float64) float64
+
+  toXyz, fromXyz [3][3]float64
+}
+
+// NewRGBSpace makes a color space out of its xy chromaticities and transfer
+// functions, which may be nil for linear spaces.
+func NewRGBSpace(name string, red, green, blue, white [2]float64, decode, encode func(float64) float64) *RGBSpace {
+  s := &RGBSpace{Name: name, Red: red, Green: green, Blue: blue, White: white, Decode: decode, Encode: encode}
+
+  // http://www.brucelindbloom.com/Eqn_RGB_XYZ_Matrix.html
+  // The columns are the XYZ of the primaries, scaled so they add up to the white.
+  prim := [3][2]float64{red, green, blue}
+  var m [3][3]float64
+  for i, p := range prim {
+    xyz := xyToXyz(p)
+    m[0][i], m[1][i], m[2][i] = xyz[0], xyz[1], xyz[2]
+  }
+  w := xyToXyz(white)
+  sr, sg, sb := mulMat3(inv3(m), w[0], w[1], w[2])
+  for i := 0; i < 3; i++ {
+    m[i][0] *= sr
+    m[i][1] *= sg
+    m[i][2] *= sb
+  }
+
+  // Colors are relative to D65, so adapt to and from it right away. The white
+  // comes from the same xy as the one of sRGB, which keeps sRGB itself exact.
+  s.toXyz = mulMat3x3(bradford(w, xyToXyz(whiteD65)), m)
+  s.fromXyz = inv3(s.toXyz)
+  return s
+}
+
+// The ITU-R BT.709 and BT.2020 transfer function, with the constants of
+// BT.2020 that make its two parts meet.
+const (
+  rec709Alpha = 1.09929682680944
+  rec709Beta  = 0.018053968510807
+)
+
+func rec709Decode(v float64) float64 {
+  if v < rec709Beta*4.5 {
+    return v / 4.5
+  }
+  return math.Pow((v+rec709Alpha-1.0)/rec709Alpha, 1.0/0.45)
+}
+
+func rec709Encode(v float64) float64 {
+  if v < rec709Beta {
+    return v * 4.5
+  }
+  return rec709Alpha*math.Pow(v, 0.45) - (rec709Alpha - 1.0)
+}
+
+func adobeDecode(v float64) float64 {
+  return math.Pow(v, 563.0/256.0)
+}
+
+func adobeEncode(v float64) float64 {
+  return math.Pow(v, 256.0/563.0)
+}
+
+func prophotoDecode(v float64) float64 {
+  if v <= 16.0/512.0 {
+    return v / 16.0
+  }
+  return math.Pow(v, 1.8)
+}
+
+func prophotoEncode(v float64) float64 {
+  if v < 1.0/512.0 {
+    return v * 16.0
+  }
+  return math.Pow(v, 1.0/1.8)
+}
+
+var (
+  whiteD65  = [2]float64{0.3127, 0.3290}
+  whiteD50  = [2]float64{0.3457, 0.3585}
+  whiteACES = [2]float64{0.32168, 0.33767}
+
+  // IEC 61966-2-1, the space of Color.
+  SRGB = NewRGBSpace("sRGB", [2]float64{0.64, 0.33}, [2]float64{0.30, 0.60}, [2]float64{0.15, 0.06}, whiteD65, linearize, delinearize)
+
+  // sRGB without its transfer function.
+  LinearSRGB = NewRGBSpace("Linear sRGB", [2]float64{0.64, 0.33}, [2]float64{0.30, 0.60}, [2]float64{0.15, 0.06}, whiteD65, nil, nil)
+
+  // The DCI-P3 primaries with D65 white and the sRGB transfer function, as
+  // used by Apple displays and CSS.
+  DisplayP3 = NewRGBSpace("Display P3", [2]float64{0.680, 0.320}, [2]float64{0.265, 0.690}, [2]float64{0.150, 0.060}, whiteD65, linearize, delinearize)
+
+  // ITU-R BT.709, the primaries of sRGB with the transfer function of video cameras.
+  Rec709 = NewRGBSpace("Rec. 709", [2]float64{0.64, 0.33}, [2]float64{0.30, 0.60}, [2]float64{0.15, 0.06}, whiteD65, rec709Decode, rec709Encode)
+
+  // ITU-R BT.2020, the SDR space of UHD television.
+  Rec2020 = NewRGBSpace("Rec. 2020", [2]float64{0.708, 0.292}, [2]float64{0.170, 0.797}, [2]float64{0.131, 0.046}, whiteD65, rec709Decode, rec709Encode)
+
+  // Adobe RGB (1998)
+  AdobeRGB = NewRGBSpace("Adobe RGB (1998)", [2]float64{0.64, 0.33}, [2]float64{0.21, 0.71}, [2]float64{0.15, 0.06}, whiteD65, adobeDecode, adobeEncode)
+
+  // ProPhoto RGB (ROMM RGB), which is relative to D50.
+  ProPhotoRGB = NewRGBSpace("ProPhoto RGB", [2]float64{0.734699, 0.265301}, [2]float64{0.159597, 0.840403}, [2]float64{0.036598, 0.000105}, whiteD50, prophotoDecode, prophotoEncode)
+
+  // ACEScg, the linear working space of ACES with the AP1 primaries.
+  ACEScg = NewRGBSpace("ACEScg", [2]float64{0.713, 0.293}, [2]float64{0.165, 0.830}, [2]float64{0.128, 0.044}, whiteACES, nil, nil)
+
+  // ACES2065-1, the linear interchange space of ACES with the AP0 primaries,
+  // which enclose all visible colors.
+  ACES2065 = NewRGBSpace("ACES2065-1", [2]float64{0.7347, 0.2653}, [2]float64{0.0, 1.0}, [2]float64{0.0001, -0.0770}, whiteACES, nil, nil)
+)
+
+// In converts the color into the values of another RGB space. These are not
+// clamped, so colors outside of its gamut have values outside of [0..1].
+func (c Color) In(space *RGBSpace) (r, g, b float64) {
+  x, y, z := mulMat3(SRGB.toXyz, signed(linearize, c.R), signed(linearize, c.G), signed(linearize, c.B))
+  r, g, b = mulMat3(space.fromXyz, x, y, z)
+  if space.Encode != nil {
+    r, g, b = signed(space.Encode, r), signed(space.Encode, g), signed(space.Encode, b)
+  }
+  return
+}
+
+// FromSpace makes a color out of the values of another RGB space. It's not
+// clamped either, so check IsValid or use Clamped for colors outside of sRGB.
+func FromSpace(space *RGBSpace, r, g, b float64) Color {
+  if space.Decode != nil {
+    r, g, b = signed(space.Decode, r), signed(space.Decode, g), signed(space.Decode, b)
+  }
+  x, y, z := mulMat3(space.toXyz, r, g, b)
+  r, g, b = mulMat3(SRGB.fromXyz, x, y, z)
+  return Color{signed(delinearize, r), signed(delinearize, g), signed(delinearize, b), 1.0}
+}
+
+// signedXyzToColor is like ColorXyz.Color, but mirrors the transfer function
+// for the negative values of colors outside of sRGB.
+func signedXyzToColor(xyz ColorXyz) Color {
+  lin := xyz.LinearRgb()
+  return Color{signed(delinearize, lin.R), signed(delinearize, lin.G), signed(delinearize, lin.B), 1.0}
+}
+
+// xyToXyz turns xy chromaticities into XYZ with Y = 1.
+func xyToXyz(xy [2]float64) [3]float64 {
+  return [3]float64{xy[0] / xy[1], 1.0, (1.0 - xy[0] - xy[1]) / xy[1]}
+}
+
+var bradfordLms = [3][3]float64{
+  {0.8951, 0.2664, -0.1614},
+  {-0.7502, 1.7135, 0.0367},
+  {0.0389, -0.0685, 1.0296},
+}
+
+// bradford is the Bradford chromatic adaptation from one white point to another.
+// http://www.brucelindbloom.com/Eqn_ChromAdapt.html
+func bradford(from, to [3]float64) [3][3]float64 {
+  fl, fm, fs := mulMat3(bradfordLms, from[0], from[1], from[2])
+  tl, tm, ts := mulMat3(bradfordLms, to[0], to[1], to[2])
+  scale := [3][3]float64{{tl / fl, 0.0, 0.0}, {0.0, tm / fm, 0.0}, {0.0, 0.0, ts / fs}}
+  return mulMat3x3(inv3(bradfordLms), mulMat3x3(scale, bradfordLms))
+}
+
+func mulMat3x3(a, b [3][3]float64) (r [3][3]float64) {
+  for i := 0; i < 3; i++ {
+    for j := 0; j < 3; j++ {
+      r[i][j] = a[i][0]*b[0][j] + a[i][1]*b[1][j] + a[i][2]*b[2][j]
+    }
+  }
+  return r
+}
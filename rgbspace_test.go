@@ -0,0 +1,100 @@
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+var allRGBSpaces = []*RGBSpace{SRGB, LinearSRGB, DisplayP3, Rec709, Rec2020, AdobeRGB, ProPhotoRGB, ACEScg, ACES2065}
+
+func TestRGBSpaceRed(t *testing.T) {
+  // sRGB red in the other spaces, from the CSS Color 4 sample code and the
+  // ACES conversion matrices.
+  cases := []struct {
+    space   *RGBSpace
+    r, g, b float64
+  }{
+    {SRGB, 1.0, 0.0, 0.0},
+    {LinearSRGB, 1.0, 0.0, 0.0},
+    {DisplayP3, 0.91749, 0.20029, 0.13856},
+    {AdobeRGB, 0.85859, 0.0, 0.0},
+    {Rec2020, 0.79198, 0.23098, 0.07376},
+    {ProPhotoRGB, 0.70225, 0.27572, 0.10355},
+    {ACEScg, 0.61310, 0.07019, 0.02062},
+    {ACES2065, 0.43963, 0.08978, 0.01754},
+  }
+  red := Color{1.0, 0.0, 0.0, 1.0}
+  for _, tc := range cases {
+    r, g, b := red.In(tc.space)
+    if math.Abs(r-tc.r) > 1e-4 || math.Abs(g-tc.g) > 1e-4 || math.Abs(b-tc.b) > 1e-4 {
+      t.Errorf("red in %v = %.5f %.5f %.5f, want %v %v %v", tc.space.Name, r, g, b, tc.r, tc.g, tc.b)
+    }
+  }
+}
+
+func TestRGBSpaceRoundTrip(t *testing.T) {
+  colors := []Color{{1, 1, 1, 1}, {0, 0, 0, 1}, {0.2, 0.4, 0.6, 1}, {0.9, 0.1, 0.5, 1}, {0.01, 0.02, 0.005, 1}}
+  for _, space := range allRGBSpaces {
+    for _, c := range colors {
+      r, g, b := c.In(space)
+      if c2 := FromSpace(space, r, g, b); !almostEqualColor(c, c2, 1e-6) {
+        t.Errorf("%v round trip of %v gives %v", space.Name, c, c2)
+      }
+    }
+    // The white of each space is adapted to the one of sRGB.
+    if w := FromSpace(space, 1, 1, 1); !almostEqualColor(w, Color{1, 1, 1, 1}, 1e-6) {
+      t.Errorf("%v white is %v", space.Name, w)
+    }
+  }
+
+  // sRGB is the space of Color, so it doesn't change anything.
+  if r, g, b := (Color{0.9, 0.1, 0.5, 1}).In(SRGB); math.Abs(r-0.9) > 1e-12 || math.Abs(g-0.1) > 1e-12 || math.Abs(b-0.5) > 1e-12 {
+    t.Errorf("sRGB of sRGB is %v %v %v", r, g, b)
+  }
+}
+
+func TestRGBSpaceOutOfGamut(t *testing.T) {
+  // Display P3 green is outside of sRGB, but survives the round trip.
+  green := FromSpace(DisplayP3, 0, 1, 0)
+  if green.IsValid() {
+    t.Errorf("Display P3 green should be outside of sRGB, got %v", green)
+  }
+  if r, g, b := green.In(DisplayP3); math.Abs(r) > 1e-6 || math.Abs(g-1) > 1e-6 || math.Abs(b) > 1e-6 {
+    t.Errorf("Display P3 green round trip gives %v %v %v", r, g, b)
+  }
+}
+
+func TestRGBSpaceMirrored(t *testing.T) {
+  // Wide-gamut primaries in sRGB, from the CSS Color 4 sample code.
+  cases := []struct {
+    space   *RGBSpace
+    r, g, b float64
+    want    Color
+  }{
+    {DisplayP3, 1, 0, 0, Color{1.0930, -0.2267, -0.1501, 1}},
+    {DisplayP3, 0, 1, 0, Color{-0.5116, 1.0183, -0.3107, 1}},
+    {Rec2020, 0, 1, 0, Color{-0.7903, 1.0563, -0.3502, 1}},
+    {SRGB, -0.2, 0.5, 1.2, Color{-0.2, 0.5, 1.2, 1}},
+  }
+  for _, tc := range cases {
+    if c := FromSpace(tc.space, tc.r, tc.g, tc.b); !almostEqualColor(c, tc.want, 2e-3) {
+      t.Errorf("FromSpace(%v, %v, %v, %v) = %#v, want %#v", tc.space.Name, tc.r, tc.g, tc.b, c, tc.want)
+    }
+  }
+
+  // Negative values are linearized like positive ones.
+  if r, _, _ := (Color{-0.2, 0.5, 0.5, 1}).In(LinearSRGB); math.Abs(r+linearize(0.2)) > 1e-4 {
+    t.Errorf("-0.2 in linear sRGB = %v, want %v", r, -linearize(0.2))
+  }
+}
+
+func TestRec709Transfer(t *testing.T) {
+  for v := 0.0; v <= 1.0; v += 0.01 {
+    if w := rec709Encode(rec709Decode(v)); math.Abs(v-w) > 1e-12 {
+      t.Errorf("Rec. 709 transfer round trip of %v gives %v", v, w)
+    }
+  }
+  if v := rec709Encode(0.18); math.Abs(v-0.409) > 1e-3 {
+    t.Errorf("Rec. 709 encodes 18%% gray as %v, want 0.409", v)
+  }
+}
@@ -0,0 +1,160 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+)
+
+// Palettes which stay distinguishable for people with color vision deficiencies.
+
+// CVDSafePaletteEx picks colors from the space allowed by the settings so that
+// the smallest distance between any two of them is as large as possible, for
+// normal vision as well as for protanopes, deuteranopes and tritanopes at once.
+// The distance is the one of the settings, CIEDE2000 by default.
+//
+// It also returns that achieved worst case distance, in the units of the
+// distance (so ΔE2000 / 100 by default). If it is small, a chart will need
+// patterns or labels in addition to colors.
+func CVDSafePaletteEx(colorsCount int, settings SoftPaletteSettings) ([]Color, float64, error) {
+  g := &Generator{settings.Rand}
+
+  dist := settings.Distance
+  if dist == nil {
+    dist = ColorLab.DistCIEDE2000
+  }
+
+  samples := settings.samples()
+  if len(samples) < colorsCount {
+    return nil, 0.0, errTooFewSamples(colorsCount, len(samples))
+  } else if colorsCount == 0 {
+    return []Color{}, math.Inf(+1), nil
+  }
+
+  // How each sample looks with normal vision and the deficiencies.
+  views := make([][4]ColorLab, len(samples))
+  for i, sample := range samples {
+    col := sample.Color()
+    views[i][0] = sample
+    for k, kind := range CVDKinds {
+      views[i][k+1] = col.SimulateCVD(kind, 1.0).Lab()
+    }
+  }
+
+  // The distance of two samples for the kind of vision which sees them closest.
+  worstDist := func(i, j int) float64 {
+    d := math.Inf(+1)
+    for k := range views[i] {
+      d = math.Min(d, dist(views[i][k], views[j][k]))
+    }
+    return d
+  }
+
+  // Start with a random sample, then keep adding the sample which is furthest
+  // away from all the ones we have. That's already pretty good.
+  chosen := make([]int, 0, colorsCount)
+  used := make([]bool, len(samples))
+  mindists := make([]float64, len(samples))
+  for i := range mindists {
+    mindists[i] = math.Inf(+1)
+  }
+  for next := g.intn(len(samples)); len(chosen) < colorsCount; {
+    chosen = append(chosen, next)
+    used[next] = true
+    best := -1.0
+    for i := range samples {
+      if used[i] {
+        continue
+      }
+      mindists[i] = math.Min(mindists[i], worstDist(i, next))
+      if mindists[i] > best {
+        best = mindists[i]
+        next = i
+      }
+    }
+  }
+
+  // closestPair finds the two chosen colors which are hardest to tell apart.
+  closestPair := func() (int, int, float64) {
+    ia, ib, mindist := 0, 0, math.Inf(+1)
+    for a := range chosen {
+      for b := a + 1; b < len(chosen); b++ {
+        if d := worstDist(chosen[a], chosen[b]); d < mindist {
+          ia, ib, mindist = a, b, d
+        }
+      }
+    }
+    return ia, ib, mindist
+  }
+
+  // Then improve it by trying to move one color of the closest pair away.
+  for i := 0; i < settings.Iterations && colorsCount > 1; i++ {
+    ia, ib, worst := closestPair()
+    if g.intn(2) == 1 {
+      ia = ib
+    }
+
+    for try := 0; try < 100; try++ {
+      candidate := g.intn(len(samples))
+      if used[candidate] {
+        continue
+      }
+      d := math.Inf(+1)
+      for ic, c := range chosen {
+        if ic != ia {
+          d = math.Min(d, worstDist(candidate, c))
+        }
+      }
+      if d > worst {
+        used[chosen[ia]] = false
+        used[candidate] = true
+        chosen[ia] = candidate
+        break
+      }
+    }
+  }
+
+  _, _, worst := closestPair()
+  colors := make([]Color, len(chosen))
+  for i, isample := range chosen {
+    colors[i] = samples[isample].Color()
+  }
+  return colors, worst, nil
+}
+
+// CVDSafePaletteEx with the Generator as source of randomness, unless the settings have their own.
+func (g *Generator) CVDSafePaletteEx(colorsCount int, settings SoftPaletteSettings) ([]Color, float64, error) {
+  if settings.Rand == nil {
+    settings.Rand = g.Rand
+  }
+  return CVDSafePaletteEx(colorsCount, settings)
+}
+
+// A wrapper which uses common parameters.
+func CVDSafePalette(colorsCount int) ([]Color, float64, error) {
+  return defaultGenerator.CVDSafePalette(colorsCount)
+}
+
+// CVDSafePalette with the Generator as source of randomness.
+func (g *Generator) CVDSafePalette(colorsCount int) ([]Color, float64, error) {
+  return g.CVDSafePaletteEx(colorsCount, SoftPaletteSettings{Iterations: 50})
+}
@@ -0,0 +1,49 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+func TestCVDSafePalette(t *testing.T) {
+  g := NewSeededGenerator(1)
+  for _, count := range []int{0, 1, 2, 5, 8} {
+    pal, worst, err := g.CVDSafePalette(count)
+    if err != nil {
+      t.Errorf("Error: %v", err)
+    }
+    if len(pal) != count {
+      t.Errorf("Requested %v colors but got %v", count, len(pal))
+    }
+    for icol, col := range pal {
+      if !col.IsValid() {
+        t.Errorf("Color %v in palette of %v is invalid: %v", icol, count, col)
+      }
+    }
+
+    // The reported distance is the one of the palette.
+    if d := ColorSlice(pal).MinDistanceCVD().Min(); math.Abs(d-worst) > 1e-9 && !(count < 2 && math.IsInf(worst, +1)) {
+      t.Errorf("Palette of %v reports worst case %v, but it is %v", count, worst, d)
+    }
+  }
+
+  // It should do better than a palette which ignores color blindness.
+  soft, _ := g.SoftPalette(6)
+  _, worst, _ := g.CVDSafePalette(6)
+  if d := ColorSlice(soft).MinDistanceCVD().Min(); worst < d {
+    t.Errorf("CVD safe palette worst case %v is worse than the soft palette's %v", worst, d)
+  }
+}
+
+func TestCVDSafeImpossibleConstraint(t *testing.T) {
+  never := func(lab ColorLab) bool { return false }
+
+  pal, _, err := CVDSafePaletteEx(10, SoftPaletteSettings{CheckColor: never, Iterations: 50})
+  if err == nil || pal != nil {
+    t.Error("Should error-out on impossible constraint!")
+  }
+}
@@ -0,0 +1,148 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+)
+
+// The steps of a shade scale, from the lightest to the darkest, as Tailwind CSS
+// names them.
+var ShadeSteps = []int{50, 100, 200, 300, 400, 500, 600, 700, 800, 900, 950}
+
+type ShadeSettings struct {
+  // The step the base color is pinned at, e.g. 500. Zero picks the step
+  // whose lightness is closest to the one of the base. Others are snapped to
+  // the closest of ShadeSteps.
+  Step int
+
+  // The space to make the shades in, GradientOkLch or GradientHcl.
+  Space GradientSpace
+
+  // The lightness of steps 50 and 950, in the lightness of the space.
+  Light float64
+  Dark  float64
+}
+
+var DefaultShades = ShadeSettings{
+  Step:  0,
+  Space: GradientOkLch,
+  Light: 0.97,
+  Dark:  0.27,
+}
+
+type Shade struct {
+  Step  int
+  Color Color
+}
+
+// Shades is a shade scale, from the lightest to the darkest step.
+type Shades []Shade
+
+// Map returns the colors by step, e.g. shades.Map()[500].
+func (s Shades) Map() map[int]Color {
+  m := make(map[int]Color, len(s))
+  for _, shade := range s {
+    m[shade.Step] = shade.Color
+  }
+  return m
+}
+
+func (s Shades) Colors() ColorSlice {
+  colors := make(ColorSlice, len(s))
+  for i, shade := range s {
+    colors[i] = shade.Color
+  }
+  return colors
+}
+
+// ShadeScale expands the base color into the ShadeSteps, keeping its hue.
+// The base is pinned at its step and lightness changes linearly from there to
+// the lightest and to the darkest step. Chroma tapers towards white and black,
+// and is lowered where it doesn't fit into sRGB.
+func ShadeScale(base Color, settings ShadeSettings) Shades {
+  space := settings.Space
+  if !space.hasHue() {
+    space = GradientOkLch
+  }
+  v := space.from(base)
+  bl, bc, bh := v[0], v[1], v[2]
+
+  // The lightness of the steps if the base were not pinned.
+  lightness := func(step float64) float64 {
+    return settings.Light + (settings.Dark-settings.Light)*(step-50.0)/900.0
+  }
+
+  pin := float64(ShadeSteps[0])
+  for _, step := range ShadeSteps {
+    s := float64(step)
+    if settings.Step == 0 {
+      if math.Abs(lightness(s)-bl) < math.Abs(lightness(pin)-bl) {
+        pin = s
+      }
+    } else if math.Abs(s-float64(settings.Step)) < math.Abs(pin-float64(settings.Step)) {
+      pin = s
+    }
+  }
+
+  // Bases lighter or darker than the ends push them.
+  light, dark := math.Max(settings.Light, bl), math.Min(settings.Dark, bl)
+
+  shades := make(Shades, len(ShadeSteps))
+  for i, step := range ShadeSteps {
+    shades[i].Step = step
+    s := float64(step)
+    if s == pin {
+      shades[i].Color = base
+      continue
+    }
+
+    var l, c float64
+    if s < pin {
+      l = light + (bl-light)*(s-50.0)/(pin-50.0)
+      c = bc * (1.0 - l) / (1.0 - bl)
+    } else {
+      l = bl + (dark-bl)*(s-pin)/(950.0-pin)
+      c = bc * l / bl
+    }
+    shades[i].Color = space.maxChroma(l, c, bh)
+  }
+  return shades
+}
+
+// maxChroma gives the color of the cylindrical space with as much of the
+// chroma as fits into sRGB.
+func (space GradientSpace) maxChroma(l, c, h float64) Color {
+  if col := space.to([3]float64{l, c, h}); col.IsValid() {
+    return col
+  }
+  lo, hi := 0.0, c
+  for i := 0; i < 20; i++ {
+    mid := (lo + hi) / 2.0
+    if space.to([3]float64{l, mid, h}).IsValid() {
+      lo = mid
+    } else {
+      hi = mid
+    }
+  }
+  return space.to([3]float64{l, lo, h}).Clamped()
+}
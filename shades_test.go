@@ -0,0 +1,84 @@
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+func TestShadeScale(t *testing.T) {
+  // Tailwind's blue-500.
+  base := ColorOkLch{0.623, 0.214, 259.815}.Color().Clamped()
+
+  for _, space := range []GradientSpace{GradientOkLch, GradientHcl} {
+    settings := DefaultShades
+    settings.Space = space
+    settings.Step = 500
+    shades := ShadeScale(base, settings)
+    if len(shades) != len(ShadeSteps) {
+      t.Fatalf("%v: got %v shades, want %v", space, len(shades), len(ShadeSteps))
+    }
+
+    m := shades.Map()
+    if m[500] != base {
+      t.Errorf("%v: base isn't pinned at 500: %v", space, m[500])
+    }
+
+    prevL, baseC := 2.0, space.from(base)[1]
+    for i, shade := range shades {
+      if shade.Step != ShadeSteps[i] {
+        t.Errorf("%v: shade %v has step %v", space, i, shade.Step)
+      }
+      if !shade.Color.IsValid() {
+        t.Errorf("%v: shade %v isn't valid: %v", space, shade.Step, shade.Color)
+      }
+      v := space.from(shade.Color)
+      if v[0] >= prevL {
+        t.Errorf("%v: shade %v isn't darker than the previous one", space, shade.Step)
+      }
+      prevL = v[0]
+      if v[1] > baseC+1e-6 {
+        t.Errorf("%v: shade %v has more chroma than the base: %v", space, shade.Step, v[1])
+      }
+      if v[1] > 0.01 && math.Abs(v[2]-space.from(base)[2]) > 1.0 {
+        t.Errorf("%v: shade %v has hue %v", space, shade.Step, v[2])
+      }
+    }
+
+    // Chroma tapers to almost nothing at the light end.
+    if c := space.from(m[50])[1]; c > 0.1*baseC {
+      t.Errorf("%v: shade 50 has chroma %v", space, c)
+    }
+  }
+}
+
+func TestShadeScaleStep(t *testing.T) {
+  light := ColorOkLch{0.9, 0.05, 100}.Color()
+
+  // The pin is picked by lightness, 0.931 at 100.
+  shades := ShadeScale(light, DefaultShades)
+  if m := shades.Map(); m[100] != light {
+    t.Errorf("base isn't pinned at 100: %v", shades)
+  }
+
+  // And a forced one pushes the ends so the order holds.
+  settings := DefaultShades
+  settings.Step = 800
+  shades = ShadeScale(light, settings)
+  if m := shades.Map(); m[800] != light {
+    t.Errorf("base isn't pinned at 800: %v", shades)
+  }
+  colors := shades.Colors()
+  for i := 1; i < len(colors); i++ {
+    if colors[i].OkLab().L > colors[i-1].OkLab().L+1e-9 {
+      t.Errorf("shade %v is lighter than the previous one", shades[i].Step)
+    }
+  }
+
+  // Other steps are snapped to the closest one.
+  for step, want := range map[int]int{540: 500, 560: 600, 975: 950, 10: 50, -200: 50} {
+    settings.Step = step
+    if m := ShadeScale(light, settings).Map(); m[want] != light {
+      t.Errorf("base with step %v isn't pinned at %v", step, want)
+    }
+  }
+}
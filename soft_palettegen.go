@@ -42,6 +42,15 @@ type SoftPaletteSettings struct {
   // Use up to 160000 or 8000 samples of the L*a*b* space (and thus calls to CheckColor).
   // Set this to true only if your CheckColor shapes the Lab space weirdly.
   ManySamples bool
+
+  // The metric used for clustering, defaults to ColorLab.Dist (CIE76) if nil.
+  // Use ColorLab.DistCIEDE2000 or ColorLab.DistOkLab for more uniform palettes,
+  // at the price of speed.
+  Distance func(a, b ColorLab) float64
+
+  // The source of randomness, the global one of math/rand if nil.
+  // Set it to get the same palette for the same seed.
+  Rand *rand.Rand
 }
 
 // Yeah, windows-stype Foo, FooEx, screw you golang...
@@ -50,35 +59,21 @@ type SoftPaletteSettings struct {
 // happens to fall outside of the color-space, which can only happen if you
 // specify a CheckColor function.
 func SoftPaletteEx(colorsCount int, settings SoftPaletteSettings) ([]Color, error) {
+  g := &Generator{settings.Rand}
 
-  // Checks whether it's a valid RGB and also fulfills the potentially provided constraint.
-  check := func(col ColorLab) bool {
-    c := col.Color()
-    return c.IsValid() && (settings.CheckColor == nil || settings.CheckColor(col))
-  }
+  check := settings.check()
 
-  // Sample the color space. These will be the points k-means is run on.
-  dl := 0.05
-  dab := 0.1
-  if settings.ManySamples {
-    dl = 0.01
-    dab = 0.05
+  dist := settings.Distance
+  if dist == nil {
+    dist = ColorLab.Dist
   }
 
-  samples := make([]ColorLab, 0, int(1.0/dl*2.0/dab*2.0/dab))
-  for l := 0.0; l <= 1.0; l += dl {
-    for a := -1.0; a <= 1.0; a += dab {
-      for b := -1.0; b <= 1.0; b += dab {
-        if check(ColorLab{l, a, b}) {
-          samples = append(samples, ColorLab{l, a, b})
-        }
-      }
-    }
-  }
+  // Sample the color space. These will be the points k-means is run on.
+  samples := settings.samples()
 
   // That would cause some infinite loops down there...
   if len(samples) < colorsCount {
-    return nil, fmt.Errorf("palettegen: more colors requested (%v) than samples available (%v). Your requested color count may be wrong, you might want to use many samples or your constraint function makes the valid color space too small.")
+    return nil, errTooFewSamples(colorsCount, len(samples))
   } else if len(samples) == colorsCount {
     return labs2cols(samples), nil // Oops?
   }
@@ -87,7 +82,7 @@ func SoftPaletteEx(colorsCount int, settings SoftPaletteSettings) ([]Color, erro
   // This helps us avoid infinite loops or arbitrary cutoffs with too restrictive constraints.
   means := make([]ColorLab, colorsCount)
   for i := 0; i < colorsCount; i++ {
-    for means[i] = samples[rand.Intn(len(samples))]; in(means, i, means[i]); means[i] = samples[rand.Intn(len(samples))] {
+    for means[i] = samples[g.intn(len(samples))]; in(means, i, means[i]); means[i] = samples[g.intn(len(samples))] {
     }
   }
 
@@ -102,9 +97,8 @@ func SoftPaletteEx(colorsCount int, settings SoftPaletteSettings) ([]Color, erro
       samples_used[isample] = false
       mindist := math.Inf(+1)
       for imean, mean := range means {
-        dist := sample.Dist(mean)
-        if dist < mindist {
-          mindist = dist
+        if d := dist(sample, mean); d < mindist {
+          mindist = d
           clusters[isample] = imean
         }
 
@@ -135,7 +129,7 @@ func SoftPaletteEx(colorsCount int, settings SoftPaletteSettings) ([]Color, erro
       } else {
         // That mean doesn't have any samples? Get a new mean from the sample list!
         var inewmean int
-        for inewmean = rand.Intn(len(samples_used)); samples_used[inewmean]; inewmean = rand.Intn(len(samples_used)) {
+        for inewmean = g.intn(len(samples_used)); samples_used[inewmean]; inewmean = g.intn(len(samples_used)) {
         }
         newmean = samples[inewmean]
         samples_used[inewmean] = true
@@ -149,25 +143,79 @@ func SoftPaletteEx(colorsCount int, settings SoftPaletteSettings) ([]Color, erro
         // New mean isn't an allowed color or doesn't have any samples!
         // Switch to medoid mode and pick the closest (unused) sample.
         // This should always find something thanks to len(samples) >= colorsCount
+        // The distance is the one of the settings, so medoids agree with the clustering.
         mindist := math.Inf(+1)
+        imedoid := -1
         for isample, sample := range samples {
           if !samples_used[isample] {
-            dist := sample.Dist(newmean)
-            if dist < mindist {
-              mindist = dist
-              newmean = sample
+            if d := dist(sample, newmean); d < mindist {
+              mindist = d
+              imedoid = isample
             }
           }
         }
+        if imedoid >= 0 {
+          means[imean] = samples[imedoid]
+          samples_used[imedoid] = true
+        }
       }
     }
   }
   return labs2cols(means), nil
 }
 
+// SoftPaletteEx with the Generator as source of randomness, unless the settings have their own.
+func (g *Generator) SoftPaletteEx(colorsCount int, settings SoftPaletteSettings) ([]Color, error) {
+  if settings.Rand == nil {
+    settings.Rand = g.Rand
+  }
+  return SoftPaletteEx(colorsCount, settings)
+}
+
+// check tells whether it's a valid RGB and also fulfills the potentially provided constraint.
+func (settings SoftPaletteSettings) check() func(col ColorLab) bool {
+  return func(col ColorLab) bool {
+    c := col.Color()
+    return c.IsValid() && (settings.CheckColor == nil || settings.CheckColor(col))
+  }
+}
+
+// samples samples the allowed part of the L*a*b* color space.
+func (settings SoftPaletteSettings) samples() []ColorLab {
+  check := settings.check()
+
+  dl := 0.05
+  dab := 0.1
+  if settings.ManySamples {
+    dl = 0.01
+    dab = 0.05
+  }
+
+  samples := make([]ColorLab, 0, int(1.0/dl*2.0/dab*2.0/dab))
+  for l := 0.0; l <= 1.0; l += dl {
+    for a := -1.0; a <= 1.0; a += dab {
+      for b := -1.0; b <= 1.0; b += dab {
+        if check(ColorLab{l, a, b}) {
+          samples = append(samples, ColorLab{l, a, b})
+        }
+      }
+    }
+  }
+  return samples
+}
+
+func errTooFewSamples(colorsCount, samplesCount int) error {
+  return fmt.Errorf("palettegen: more colors requested (%v) than samples available (%v). Your requested color count may be wrong, you might want to use many samples or your constraint function makes the valid color space too small.", colorsCount, samplesCount)
+}
+
 // A wrapper which uses common parameters.
 func SoftPalette(colorsCount int) ([]Color, error) {
-  return SoftPaletteEx(colorsCount, SoftPaletteSettings{nil, 50, false})
+  return defaultGenerator.SoftPalette(colorsCount)
+}
+
+// SoftPalette with the Generator as source of randomness.
+func (g *Generator) SoftPalette(colorsCount int) ([]Color, error) {
+  return g.SoftPaletteEx(colorsCount, SoftPaletteSettings{Iterations: 50})
 }
 
 func in(haystack []ColorLab, upto int, needle ColorLab) bool {
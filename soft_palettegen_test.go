@@ -56,7 +56,7 @@ func TestColorCount(t *testing.T) {
 func TestImpossibleConstraint(t *testing.T) {
   never := func(lab ColorLab) bool { return false }
 
-  pal, err := SoftPaletteEx(10, SoftPaletteSettings{never, 50, true})
+  pal, err := SoftPaletteEx(10, SoftPaletteSettings{CheckColor: never, Iterations: 50, ManySamples: true})
   if err == nil || pal != nil {
     t.Error("Should error-out on impossible constraint!")
   }
@@ -66,7 +66,7 @@ func TestImpossibleConstraint(t *testing.T) {
 func TestConstraint(t *testing.T) {
   octant := func(lab ColorLab) bool { return lab.L <= 0.5 && lab.A <= 0.0 && lab.B <= 0.0 }
 
-  pal, err := SoftPaletteEx(100, SoftPaletteSettings{octant, 50, true})
+  pal, err := SoftPaletteEx(100, SoftPaletteSettings{CheckColor: octant, Iterations: 50, ManySamples: true})
   if err != nil {
     t.Errorf("Error: %v", err)
   }
@@ -83,3 +83,50 @@ func TestConstraint(t *testing.T) {
     }
   }
 }
+
+// Constraints can be expressed in OKLCH as well.
+func TestOkLchConstraint(t *testing.T) {
+  pastel := func(lab ColorLab) bool {
+    lch := lab.OkLab().OkLch()
+    return lch.L >= 0.8 && lch.C <= 0.1
+  }
+
+  pal, err := SoftPaletteEx(8, SoftPaletteSettings{CheckColor: pastel, Iterations: 50, ManySamples: true})
+  if err != nil {
+    t.Errorf("Error: %v", err)
+  }
+
+  for icol, col := range pal {
+    if lch := col.OkLch(); lch.L < 0.8-1e-6 || lch.C > 0.1+1e-6 {
+      t.Errorf("Color %v in OKLCH constrained palette violates the constraint: %v (oklch: %v)", icol, col, lch)
+    }
+  }
+}
+
+// Check that custom metrics are used and give valid, distinct palettes.
+func TestDistanceMetric(t *testing.T) {
+  calls := 0
+  ciede2000 := func(a, b ColorLab) float64 {
+    calls++
+    return a.DistCIEDE2000(b)
+  }
+
+  for _, dist := range []func(a, b ColorLab) float64{ciede2000, ColorLab.DistOkLab} {
+    pal, err := SoftPaletteEx(6, SoftPaletteSettings{Iterations: 10, Distance: dist})
+    if err != nil {
+      t.Errorf("Error: %v", err)
+    }
+    if len(pal) != 6 {
+      t.Errorf("Requested 6 colors but got %v", len(pal))
+    }
+    for icol, col := range pal {
+      if !col.IsValid() {
+        t.Errorf("Color %v in palette is invalid: %v", icol, col)
+      }
+    }
+  }
+
+  if calls == 0 {
+    t.Error("The Distance of the settings was never called")
+  }
+}
@@ -0,0 +1,190 @@
+// This conceptual change port https://github.com/lucasb-eyer/go-colorful
+//
+// Copyright (c) 2014 Dmitry Ponomarev
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the
+// Software, and to permit persons to whom the Software is furnished to do so, subject
+// to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies
+//  or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED,
+// INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR
+// PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+
+package colorful
+
+import (
+  "math"
+)
+
+// Scale maps t in [0..1] to a color. Splines through colors are scales.
+type Scale func(t float64) Color
+
+// Colors samples n colors evenly from the scale, both ends included.
+func (sc Scale) Colors(n int) ColorSlice {
+  if n <= 0 {
+    return ColorSlice{}
+  }
+  colors := make(ColorSlice, n)
+  for i := range colors {
+    t := 0.0
+    if n > 1 {
+      t = float64(i) / float64(n-1)
+    }
+    colors[i] = sc(t)
+  }
+  return colors
+}
+
+// Gradient samples the scale at n evenly spaced stops, interpolated in RGB.
+func (sc Scale) Gradient(n int) *Gradient {
+  g := NewGradient(sc.Colors(n)...)
+  g.Space = GradientRGB
+  return g
+}
+
+// CorrectLightness reparametrizes the scale so that its lightness L* changes
+// linearly from one end to the other, like the lightness correction of chroma.js.
+// This is only meaningful if the lightness of the scale is monotonic.
+func (sc Scale) CorrectLightness() Scale {
+  l0, l1 := sc(0.0).Lab().L, sc(1.0).Lab().L
+  return func(t float64) Color {
+    target := l0 + t*(l1-l0)
+    lo, hi, u := 0.0, 1.0, t
+    for i := 0; i < 32; i++ {
+      l := sc(u).Lab().L
+      if math.Abs(l-target) < 1e-6 {
+        break
+      }
+      if (l < target) == (l0 < l1) {
+        lo = u
+      } else {
+        hi = u
+      }
+      u = (lo + hi) / 2.0
+    }
+    return sc(u)
+  }
+}
+
+// Bezier is the Bezier curve with the colors as control points in the space,
+// which passes through the first and the last one only.
+func (s ColorSlice) Bezier(space GradientSpace) Scale {
+  space = space.cartesian()
+  points := s.points(space)
+  return func(t float64) Color {
+    if len(points) == 0 {
+      return Color{}
+    }
+    t = clamp01(t)
+
+    // De Casteljau's algorithm.
+    p := append([][4]float64{}, points...)
+    for n := len(p) - 1; n > 0; n-- {
+      for i := 0; i < n; i++ {
+        for k := range p[i] {
+          p[i][k] += t * (p[i+1][k] - p[i][k])
+        }
+      }
+    }
+    return space.point(p[0])
+  }
+}
+
+// BSpline is the uniform cubic B-spline with the colors as control points in
+// the space. It is smoother than Bezier for many colors and also passes through
+// the first and the last one only.
+func (s ColorSlice) BSpline(space GradientSpace) Scale {
+  space = space.cartesian()
+  points := s.points(space)
+  if len(points) > 0 {
+    // Triple the ends so the curve starts and ends at them.
+    first, last := points[0], points[len(points)-1]
+    points = append([][4]float64{first, first}, append(points, last, last)...)
+  }
+  return spline(space, points, func(p0, p1, p2, p3, u float64) float64 {
+    u2, u3 := u*u, u*u*u
+    return ((1.0-3.0*u+3.0*u2-u3)*p0 + (4.0-6.0*u2+3.0*u3)*p1 + (1.0+3.0*u+3.0*u2-3.0*u3)*p2 + u3*p3) / 6.0
+  })
+}
+
+// CatmullRom is the uniform Catmull-Rom spline through the colors in the space.
+// Unlike BSpline, it passes through every color, at t = i/(len(s)-1).
+func (s ColorSlice) CatmullRom(space GradientSpace) Scale {
+  space = space.cartesian()
+  points := s.points(space)
+  if len(points) > 1 {
+    // Extend the ends in the direction of their neighbours.
+    first, last := points[0], points[len(points)-1]
+    for k := range first {
+      first[k] = 2.0*first[k] - points[1][k]
+      last[k] = 2.0*last[k] - points[len(points)-2][k]
+    }
+    points = append([][4]float64{first}, append(points, last)...)
+  } else if len(points) == 1 {
+    points = [][4]float64{points[0], points[0], points[0], points[0]}
+  }
+  return spline(space, points, func(p0, p1, p2, p3, u float64) float64 {
+    u2, u3 := u*u, u*u*u
+    return 0.5 * (2.0*p1 + (p2-p0)*u + (2.0*p0-5.0*p1+4.0*p2-p3)*u2 + (3.0*p1-p0-3.0*p2+p3)*u3)
+  })
+}
+
+// spline evaluates the uniform cubic spline of the basis over the points,
+// of which there are len(points)-3 segments.
+func spline(space GradientSpace, points [][4]float64, basis func(p0, p1, p2, p3, u float64) float64) Scale {
+  return func(t float64) Color {
+    if len(points) < 4 {
+      return Color{}
+    }
+    segments := len(points) - 3
+    u := clamp01(t) * float64(segments)
+    i := int(u)
+    if i == segments {
+      i--
+    }
+    u -= float64(i)
+
+    var p [4]float64
+    for k := range p {
+      p[k] = basis(points[i][k], points[i+1][k], points[i+2][k], points[i+3][k], u)
+    }
+    return space.point(p)
+  }
+}
+
+// cartesian is the Cartesian counterpart of a cylindrical space, since splines
+// through hue angles make no sense.
+func (space GradientSpace) cartesian() GradientSpace {
+  switch space {
+  case GradientHcl:
+    return GradientLab
+  case GradientOkLch:
+    return GradientOkLab
+  }
+  return space
+}
+
+// points are the colors in the space, with alpha as fourth value.
+func (s ColorSlice) points(space GradientSpace) [][4]float64 {
+  points := make([][4]float64, len(s))
+  for i, c := range s {
+    v := space.from(c)
+    points[i] = [4]float64{v[0], v[1], v[2], c.A}
+  }
+  return points
+}
+
+func (space GradientSpace) point(p [4]float64) Color {
+  c := space.to([3]float64{p[0], p[1], p[2]}).Clamped()
+  c.A = clamp01(p[3])
+  return c
+}
@@ -0,0 +1,97 @@
+package colorful
+
+import (
+  "math"
+  "testing"
+)
+
+var splineColors = ColorSlice{{1, 1, 0.8, 1}, {0.95, 0.5, 0.1, 1}, {0.6, 0.1, 0.3, 1}, {0.1, 0.05, 0.2, 1}}
+
+func TestBezier(t *testing.T) {
+  // With two colors, it's a straight blend.
+  c1, c2 := splineColors[0], splineColors[3]
+  lab, oklab := ColorSlice{c1, c2}.Bezier(GradientLab), ColorSlice{c1, c2}.Bezier(GradientOkLab)
+  for _, x := range []float64{0.0, 0.25, 0.5, 1.0} {
+    if got, want := lab(x), c1.BlendLab(c2, x); !almostEqualColor(got, want, 1e-6) {
+      t.Errorf("Lab Bezier(%v) = %v, want %v", x, got, want)
+    }
+    if got, want := oklab(x), c1.BlendOkLab(c2, x); !almostEqualColor(got, want, 1e-6) {
+      t.Errorf("OKLab Bezier(%v) = %v, want %v", x, got, want)
+    }
+  }
+
+  // Quadratic: (1-t)²P0 + 2t(1-t)P1 + t²P2.
+  three := ColorSlice{splineColors[0], splineColors[1], splineColors[3]}
+  l := [3]float64{}
+  for i, c := range three {
+    l[i] = c.Lab().L
+  }
+  if got, want := three.Bezier(GradientLab)(0.5).Lab().L, 0.25*l[0]+0.5*l[1]+0.25*l[2]; math.Abs(got-want) > 1e-6 {
+    t.Errorf("quadratic Bezier L* is %v, want %v", got, want)
+  }
+}
+
+func TestSplineEnds(t *testing.T) {
+  for _, space := range []GradientSpace{GradientLab, GradientOkLab, GradientOkLch} {
+    for name, sc := range map[string]Scale{
+      "Bezier":     splineColors.Bezier(space),
+      "BSpline":    splineColors.BSpline(space),
+      "CatmullRom": splineColors.CatmullRom(space),
+    } {
+      if got := sc(0.0); !almostEqualColor(got, splineColors[0], 1e-6) {
+        t.Errorf("%v in %v starts at %v, want %v", name, space, got, splineColors[0])
+      }
+      if got := sc(1.0); !almostEqualColor(got, splineColors[3], 1e-6) {
+        t.Errorf("%v in %v ends at %v, want %v", name, space, got, splineColors[3])
+      }
+    }
+  }
+}
+
+func TestCatmullRom(t *testing.T) {
+  sc := splineColors.CatmullRom(GradientOkLab)
+  for i, want := range splineColors {
+    x := float64(i) / float64(len(splineColors)-1)
+    if got := sc(x); !almostEqualColor(got, want, 1e-6) {
+      t.Errorf("CatmullRom(%v) = %v, want %v", x, got, want)
+    }
+  }
+
+  // No kinks at the stops: the slope is the same on both sides.
+  for _, sc := range []Scale{splineColors.CatmullRom(GradientLab), splineColors.BSpline(GradientLab)} {
+    for _, x := range []float64{1.0 / 3.0, 2.0 / 3.0} {
+      h := 1e-5
+      before := (sc(x).Lab().L - sc(x-h).Lab().L) / h
+      after := (sc(x+h).Lab().L - sc(x).Lab().L) / h
+      if math.Abs(before-after) > 1e-3 {
+        t.Errorf("kink at %v: slope %v before, %v after", x, before, after)
+      }
+    }
+  }
+}
+
+func TestCorrectLightness(t *testing.T) {
+  sc := splineColors.Bezier(GradientLab).CorrectLightness()
+  l0, l1 := splineColors[0].Lab().L, splineColors[3].Lab().L
+  for i, c := range sc.Colors(9) {
+    want := l0 + float64(i)/8.0*(l1-l0)
+    if got := c.Lab().L; math.Abs(got-want) > 1e-4 {
+      t.Errorf("color %v has L* %v, want %v", i, got, want)
+    }
+  }
+}
+
+func TestSplineDegenerate(t *testing.T) {
+  one := ColorSlice{{0.2, 0.4, 0.6, 1}}
+  for _, sc := range []Scale{one.Bezier(GradientOkLab), one.BSpline(GradientOkLab), one.CatmullRom(GradientOkLab)} {
+    if got := sc(0.3); !almostEqualColor(got, one[0], 1e-6) {
+      t.Errorf("single color scale gives %v, want %v", got, one[0])
+    }
+  }
+  if got := (ColorSlice{}).CatmullRom(GradientLab)(0.5); got != (Color{}) {
+    t.Errorf("empty scale gives %v", got)
+  }
+  if n := len(one.Bezier(GradientLab).Colors(0)); n != 0 {
+    t.Errorf("Colors(0) has %v colors", n)
+  }
+}
@@ -20,26 +20,32 @@
 
 package colorful
 
-import (
-  "math/rand"
-)
-
 // Uses the HSV color space to generate colors with similar S,V but distributed
 // evenly along their Hue. This is fast but not always pretty.
 // If you've got time to spare, use Lab (the non-fast below).
-func FastWarmPalette(colorsCount int) (colors []Color) {
+func FastWarmPalette(colorsCount int) []Color {
+  return defaultGenerator.FastWarmPalette(colorsCount)
+}
+
+// FastWarmPalette with the Generator as source of randomness.
+func (g *Generator) FastWarmPalette(colorsCount int) (colors []Color) {
   colors = make([]Color, colorsCount)
 
   for i := 0; i < colorsCount; i++ {
-    colors[i] = ColorHsv{float64(i) * (360.0 / float64(colorsCount)), 0.55 + rand.Float64()*0.2, 0.35 + rand.Float64()*0.2}.Color()
+    colors[i] = ColorHsv{float64(i) * (360.0 / float64(colorsCount)), 0.55 + g.float64()*0.2, 0.35 + g.float64()*0.2}.Color()
   }
   return
 }
 
 func WarmPalette(colorsCount int) ([]Color, error) {
+  return defaultGenerator.WarmPalette(colorsCount)
+}
+
+// WarmPalette with the Generator as source of randomness.
+func (g *Generator) WarmPalette(colorsCount int) ([]Color, error) {
   warmy := func(lab ColorLab) bool {
     c := lab.Hcl()
     return 0.1 <= c.C && c.C <= 0.4 && 0.2 <= lab.L && lab.L <= 0.5
   }
-  return SoftPaletteEx(colorsCount, SoftPaletteSettings{warmy, 50, true})
+  return g.SoftPaletteEx(colorsCount, SoftPaletteSettings{CheckColor: warmy, Iterations: 50, ManySamples: true})
 }